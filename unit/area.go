@@ -14,7 +14,10 @@
 
 package unit
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+)
 
 // Area represents a two-dimensional measurement in square meters as a float64.
 type Area float64
@@ -67,6 +70,11 @@ func (a Area) Acres() float64 { return float64(a / Acre) }
 // SquareInches returns the area in square inches.
 func (a Area) SquareInches() float64 { return float64(a / SquareInch) }
 
+// Times returns the volume swept by an area a extruded along a length l.
+func (a Area) Times(l Length) Volume {
+	return Volume(a.SquareMeters() * l.Meters())
+}
+
 // String returns a string representation of the area in square meters.
 //
 // If possible, the area will be formatted with an appropriate SI prefix,
@@ -97,7 +105,7 @@ func (a Area) format() (string, areaUnitDesc) {
 	}
 	for _, u := range areaUnitThresholds {
 		if a.Abs() >= u.area {
-			return fmt.Sprintf("%v", float64(a/u.area)), u
+			return formatSignificant(float64(a / u.area)), u
 		}
 	}
 	return fmt.Sprintf("%g", a.SquareMeters()), squareMeterDesc
@@ -121,4 +129,53 @@ var (
 		{SquareCentimeter, "SquareCentimeter", "cm^2"},
 		{SquareMillimeter, "SquareMillimeter", "mm^2"},
 	}
+
+	squareMileDesc = areaUnitDesc{SquareMile, "SquareMile", "sq mi"}
+	acreDesc       = areaUnitDesc{Acre, "Acre", "acre"}
+	squareFootDesc = areaUnitDesc{SquareFoot, "SquareFoot", "sq ft"}
+	squareInchDesc = areaUnitDesc{SquareInch, "SquareInch", "sq in"}
+
+	// imperialAreaThresholds mirrors areaUnitThresholds, but for the square
+	// mile/acre/square foot/square inch family used by StringImperial.
+	imperialAreaThresholds = []areaUnitDesc{
+		// NOTE: keep in descending order so that imperialFormat() works correctly.
+		squareMileDesc,
+		acreDesc,
+		squareFootDesc,
+		squareInchDesc,
+	}
 )
+
+func (a Area) imperialFormat() (string, areaUnitDesc) {
+	if a.Abs() >= 1e6*squareMileDesc.area {
+		return fmt.Sprintf("%g", a/squareMileDesc.area), squareMileDesc
+	}
+	for _, u := range imperialAreaThresholds {
+		if a.Abs() >= u.area {
+			return formatSignificant(float64(a / u.area)), u
+		}
+	}
+	return fmt.Sprintf("%g", a/squareInchDesc.area), squareInchDesc
+}
+
+// StringImperial returns a string representation of the area like String,
+// but preferring the square mile/acre/square foot/square inch family of
+// units over the metric one.
+func (a Area) StringImperial() string {
+	value, desc := a.imperialFormat()
+	return fmt.Sprintf("%v%v", value, desc.symbol)
+}
+
+// Format implements fmt.Formatter. %v and %s render as String does; %#v
+// renders as GoString does; %g, %e, and %f (and their upper-case forms)
+// render the area in square meters honoring the usual flags, width, and
+// precision.
+func (a Area) Format(f fmt.State, verb rune) {
+	formatState(f, verb, a.SquareMeters(), a.String, a.GoString)
+}
+
+// Formatf renders the area using a layout combining a printf numeric verb
+// with the name of the target unit, e.g. a.Formatf("%.3g ha").
+func (a Area) Formatf(layout string) string {
+	return formatf(reflect.TypeOf(a), a.SquareMeters(), layout)
+}
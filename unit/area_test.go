@@ -93,7 +93,7 @@ func TestAreaString(t *testing.T) {
 		{1234.56 * SquareCentimeter, "1234.56cm^2"},
 		{SquareMillimeter, "1mm^2"},
 		{98.7654321 * SquareMillimeter, "98.7654321mm^2"},
-		{SquareMile, "2.5899881103360003km^2"},
+		{SquareMile, "2.589988110336km^2"},
 		// area of Moloka'i
 		{673.4 * SquareKilometer, "673.4km^2"},
 		// area of Malawi
@@ -118,7 +118,7 @@ func TestAreaGoString(t *testing.T) {
 		{1234.56 * SquareCentimeter, "1234.56 * SquareCentimeter"},
 		{SquareMillimeter, "1 * SquareMillimeter"},
 		{98.7654321 * SquareMillimeter, "98.7654321 * SquareMillimeter"},
-		{SquareMile, "2.5899881103360003 * SquareKilometer"},
+		{SquareMile, "2.589988110336 * SquareKilometer"},
 		// area of Moloka'i
 		{673.4 * SquareKilometer, "673.4 * SquareKilometer"},
 		// area of Malawi
@@ -0,0 +1,52 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import "math"
+
+// BaseName returns the canonical Prometheus base-unit suffix for Length
+// metrics, "meters", as recommended by promlint
+// (https://prometheus.io/docs/practices/naming/#base-units).
+func (l Length) BaseName() string { return "meters" }
+
+// BaseName returns the canonical Prometheus base-unit suffix for Speed
+// metrics, "meters_per_second".
+func (s Speed) BaseName() string { return "meters_per_second" }
+
+// BaseName returns the canonical Prometheus base-unit suffix for
+// Temperature metrics, "celsius". promlint accepts celsius, not Kelvin,
+// as a base temperature unit.
+func (t Temperature) BaseName() string { return "celsius" }
+
+// MetricName returns a Prometheus-style metric name formed from prefix and
+// q's canonical base-unit suffix (see BaseName), along with q's value
+// expressed in that base unit, e.g.
+//
+//	MetricName("altitude", 29031*Foot) // returns ("altitude_meters", 8848.6488)
+//
+// q must be a Length, Speed, or Temperature; any other type returns the
+// unmodified prefix and math.NaN().
+func MetricName(prefix string, q any) (name string, value float64) {
+	switch v := q.(type) {
+	case Length:
+		return prefix + "_" + v.BaseName(), v.Meters()
+	case Speed:
+		return prefix + "_" + v.BaseName(), v.MetersPerSecond()
+	case Temperature:
+		return prefix + "_" + v.BaseName(), v.DegreesCelsius()
+	default:
+		return prefix, math.NaN()
+	}
+}
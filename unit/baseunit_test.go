@@ -0,0 +1,69 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestBaseName(t *testing.T) {
+	if got, want := Length(0).BaseName(), "meters"; got != want {
+		t.Errorf("Length.BaseName() = %q, want %q", got, want)
+	}
+	if got, want := Speed(0).BaseName(), "meters_per_second"; got != want {
+		t.Errorf("Speed.BaseName() = %q, want %q", got, want)
+	}
+	if got, want := Temperature(0).BaseName(), "celsius"; got != want {
+		t.Errorf("Temperature.BaseName() = %q, want %q", got, want)
+	}
+}
+
+func TestMetricName(t *testing.T) {
+	name, value := MetricName("altitude", 29031*Foot)
+	if got, want := name, "altitude_meters"; got != want {
+		t.Errorf("MetricName(\"altitude\", 29031*Foot) name = %q, want %q", got, want)
+	}
+	if !cmp.Equal(value, 29031*0.3048, cmpopts.EquateApprox(0, 1e-6)) {
+		t.Errorf("MetricName(\"altitude\", 29031*Foot) value = %v, want %v", value, 29031*0.3048)
+	}
+
+	name, value = MetricName("wind", 20*Knot)
+	if got, want := name, "wind_meters_per_second"; got != want {
+		t.Errorf("MetricName(\"wind\", 20*Knot) name = %q, want %q", got, want)
+	}
+	if !cmp.Equal(value, (20 * Knot).MetersPerSecond(), cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("MetricName(\"wind\", 20*Knot) value = %v, want %v", value, (20 * Knot).MetersPerSecond())
+	}
+
+	name, value = MetricName("outside_temp", TemperatureFromDegreesCelsius(15))
+	if got, want := name, "outside_temp_celsius"; got != want {
+		t.Errorf("MetricName name = %q, want %q", got, want)
+	}
+	if !cmp.Equal(value, 15.0, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("MetricName value = %v, want %v", value, 15.0)
+	}
+
+	name, value = MetricName("prefix", "not a quantity")
+	if got, want := name, "prefix"; got != want {
+		t.Errorf("MetricName of an unsupported type name = %q, want %q", got, want)
+	}
+	if !math.IsNaN(value) {
+		t.Errorf("MetricName of an unsupported type value = %v, want NaN", value)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import "fmt"
+
+// Density represents mass per unit volume, in kilograms per cubic meter, as
+// a float64. Construct one with Mass.Over.
+type Density float64
+
+// Common density units.
+const (
+	KilogramPerCubicMeter  Density = 1
+	GramPerCubicCentimeter         = 1000 * KilogramPerCubicMeter
+)
+
+// KilogramsPerCubicMeter returns the density in kilograms per cubic meter.
+func (d Density) KilogramsPerCubicMeter() float64 { return float64(d) }
+
+// GramsPerCubicCentimeter returns the density in grams per cubic centimeter.
+func (d Density) GramsPerCubicCentimeter() float64 { return float64(d / GramPerCubicCentimeter) }
+
+// String returns a string representation of the density in kilograms per
+// cubic meter using compact number syntax. For example:
+//
+//	"1000 kg/m^3" (density of water)
+//	"1.225 kg/m^3" (density of air at sea level)
+func (d Density) String() string {
+	return fmt.Sprintf("%g kg/m^3", d.KilogramsPerCubicMeter())
+}
+
+// GoString returns a Go syntax expression of the density. For example:
+//
+//	"1000 * KilogramPerCubicMeter"
+func (d Density) GoString() string {
+	return fmt.Sprintf("%g * KilogramPerCubicMeter", d.KilogramsPerCubicMeter())
+}
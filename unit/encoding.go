@@ -0,0 +1,227 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+)
+
+// This file wires every quantity type into the standard encoding
+// interfaces: encoding.TextMarshaler/TextUnmarshaler, json.Marshaler/
+// Unmarshaler, sql.Scanner/driver.Valuer, and flag.Value. The canonical
+// marshaled form is the type's String() output (e.g. "1.2km", "70 °F");
+// unmarshaling accepts anything Parse does. Each method is a one-line
+// adapter onto a shared, generic implementation below, since the logic is
+// identical across dimensions.
+
+// marshalText renders v using its String method, which is the canonical
+// form accepted back by Parse.
+func marshalText(v fmt.Stringer) ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// unmarshalText parses data into out using Parse.
+func unmarshalText[T ~float64](data []byte, out *T) error {
+	return Parse(string(data), out)
+}
+
+// marshalJSON renders v as a JSON string using its String method.
+func marshalJSON(v fmt.Stringer) ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// jsonNumericUnit holds, per dimension type, the unit a bare JSON number is
+// interpreted in when decoding. Types absent from the map use a scale of 1,
+// i.e. the dimension's base SI unit. Set via SetJSONNumericUnit.
+var jsonNumericUnit = map[reflect.Type]float64{}
+
+// SetJSONNumericUnit changes the unit a bare JSON number (one with no string
+// suffix) is interpreted in when decoding into T, for schemas that already
+// store a plain float64 in a unit other than T's base SI unit. For example,
+// SetJSONNumericUnit(Kilometer) makes `"altitude": 5` decode as 5km instead
+// of 5m.
+func SetJSONNumericUnit[T ~float64](scale T) {
+	jsonNumericUnit[reflect.TypeOf(T(0))] = float64(scale)
+}
+
+// unmarshalJSON accepts either a JSON string, parsed the same way as
+// UnmarshalText, or a bare JSON number, interpreted according to
+// jsonNumericUnit. The latter exists for compatibility with schemas that
+// already store a plain float64.
+func unmarshalJSON[T ~float64](data []byte, out *T) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return Parse(s, out)
+	}
+	var f float64
+	if err := json.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("unit: cannot unmarshal %s into %T: %w", data, *out, err)
+	}
+	scale := 1.0
+	if s, ok := jsonNumericUnit[reflect.TypeOf(*out)]; ok {
+		scale = s
+	}
+	*out = T(f * scale)
+	return nil
+}
+
+// value implements driver.Valuer using v's String method.
+func value(v fmt.Stringer) (driver.Value, error) {
+	return v.String(), nil
+}
+
+// scan implements sql.Scanner, accepting anything Parse does, a bare
+// float64/int64 (interpreted as the dimension's base SI unit), or nil
+// (interpreted as zero).
+func scan[T ~float64](src any, out *T) error {
+	switch v := src.(type) {
+	case string:
+		return Parse(v, out)
+	case []byte:
+		return Parse(string(v), out)
+	case float64:
+		*out = T(v)
+		return nil
+	case int64:
+		*out = T(float64(v))
+		return nil
+	case nil:
+		*out = 0
+		return nil
+	default:
+		return fmt.Errorf("unit: cannot scan %T into %T", src, *out)
+	}
+}
+
+// setFlag implements flag.Value's Set method using Parse.
+func setFlag[T ~float64](s string, out *T) error {
+	return Parse(s, out)
+}
+
+func (l Length) MarshalText() ([]byte, error)     { return marshalText(l) }
+func (l *Length) UnmarshalText(data []byte) error { return unmarshalText(data, l) }
+func (l Length) MarshalJSON() ([]byte, error)     { return marshalJSON(l) }
+func (l *Length) UnmarshalJSON(data []byte) error { return unmarshalJSON(data, l) }
+func (l Length) Value() (driver.Value, error)     { return value(l) }
+func (l *Length) Scan(src any) error              { return scan(src, l) }
+func (l *Length) Set(s string) error              { return setFlag(s, l) }
+
+// LengthFlag registers a Length flag with fs, accepting any form Parse
+// does (e.g. --radius=25km), and returns a pointer to its value.
+func LengthFlag(fs *flag.FlagSet, name string, def Length, usage string) *Length {
+	v := def
+	fs.Var(&v, name, usage)
+	return &v
+}
+
+func (a Area) MarshalText() ([]byte, error)     { return marshalText(a) }
+func (a *Area) UnmarshalText(data []byte) error { return unmarshalText(data, a) }
+func (a Area) MarshalJSON() ([]byte, error)     { return marshalJSON(a) }
+func (a *Area) UnmarshalJSON(data []byte) error { return unmarshalJSON(data, a) }
+func (a Area) Value() (driver.Value, error)     { return value(a) }
+func (a *Area) Scan(src any) error              { return scan(src, a) }
+func (a *Area) Set(s string) error              { return setFlag(s, a) }
+
+// AreaFlag registers an Area flag with fs, accepting any form Parse does
+// (e.g. --plot=5acre), and returns a pointer to its value.
+func AreaFlag(fs *flag.FlagSet, name string, def Area, usage string) *Area {
+	v := def
+	fs.Var(&v, name, usage)
+	return &v
+}
+
+func (s Speed) MarshalText() ([]byte, error)     { return marshalText(s) }
+func (s *Speed) UnmarshalText(data []byte) error { return unmarshalText(data, s) }
+func (s Speed) MarshalJSON() ([]byte, error)     { return marshalJSON(s) }
+func (s *Speed) UnmarshalJSON(data []byte) error { return unmarshalJSON(data, s) }
+func (s Speed) Value() (driver.Value, error)     { return value(s) }
+func (s *Speed) Scan(src any) error              { return scan(src, s) }
+func (s *Speed) Set(v string) error              { return setFlag(v, s) }
+
+// SpeedFlag registers a Speed flag with fs, accepting any form Parse does
+// (e.g. --wind=20kts), and returns a pointer to its value.
+func SpeedFlag(fs *flag.FlagSet, name string, def Speed, usage string) *Speed {
+	v := def
+	fs.Var(&v, name, usage)
+	return &v
+}
+
+func (t Temperature) MarshalText() ([]byte, error)     { return marshalText(t) }
+func (t *Temperature) UnmarshalText(data []byte) error { return unmarshalText(data, t) }
+func (t Temperature) MarshalJSON() ([]byte, error)     { return marshalJSON(t) }
+func (t *Temperature) UnmarshalJSON(data []byte) error { return unmarshalJSON(data, t) }
+func (t Temperature) Value() (driver.Value, error)     { return value(t) }
+func (t *Temperature) Scan(src any) error              { return scan(src, t) }
+func (t *Temperature) Set(s string) error              { return setFlag(s, t) }
+
+// TemperatureFlag registers a Temperature flag with fs, accepting any form
+// Parse does (e.g. --boil=100degC), and returns a pointer to its value.
+func TemperatureFlag(fs *flag.FlagSet, name string, def Temperature, usage string) *Temperature {
+	v := def
+	fs.Var(&v, name, usage)
+	return &v
+}
+
+func (v Volume) MarshalText() ([]byte, error)     { return marshalText(v) }
+func (v *Volume) UnmarshalText(data []byte) error { return unmarshalText(data, v) }
+func (v Volume) MarshalJSON() ([]byte, error)     { return marshalJSON(v) }
+func (v *Volume) UnmarshalJSON(data []byte) error { return unmarshalJSON(data, v) }
+func (v Volume) Value() (driver.Value, error)     { return value(v) }
+func (v *Volume) Scan(src any) error              { return scan(src, v) }
+func (v *Volume) Set(s string) error              { return setFlag(s, v) }
+
+// VolumeFlag registers a Volume flag with fs, accepting any form Parse does
+// (e.g. --tank=50L), and returns a pointer to its value.
+func VolumeFlag(fs *flag.FlagSet, name string, def Volume, usage string) *Volume {
+	v := def
+	fs.Var(&v, name, usage)
+	return &v
+}
+
+func (m Mass) MarshalText() ([]byte, error)     { return marshalText(m) }
+func (m *Mass) UnmarshalText(data []byte) error { return unmarshalText(data, m) }
+func (m Mass) MarshalJSON() ([]byte, error)     { return marshalJSON(m) }
+func (m *Mass) UnmarshalJSON(data []byte) error { return unmarshalJSON(data, m) }
+func (m Mass) Value() (driver.Value, error)     { return value(m) }
+func (m *Mass) Scan(src any) error              { return scan(src, m) }
+func (m *Mass) Set(s string) error              { return setFlag(s, m) }
+
+// MassFlag registers a Mass flag with fs, accepting any form Parse does
+// (e.g. --payload=150lb), and returns a pointer to its value.
+func MassFlag(fs *flag.FlagSet, name string, def Mass, usage string) *Mass {
+	v := def
+	fs.Var(&v, name, usage)
+	return &v
+}
+
+func (p Pressure) MarshalText() ([]byte, error)     { return marshalText(p) }
+func (p *Pressure) UnmarshalText(data []byte) error { return unmarshalText(data, p) }
+func (p Pressure) MarshalJSON() ([]byte, error)     { return marshalJSON(p) }
+func (p *Pressure) UnmarshalJSON(data []byte) error { return unmarshalJSON(data, p) }
+func (p Pressure) Value() (driver.Value, error)     { return value(p) }
+func (p *Pressure) Scan(src any) error              { return scan(src, p) }
+func (p *Pressure) Set(s string) error              { return setFlag(s, p) }
+
+// PressureFlag registers a Pressure flag with fs, accepting any form Parse
+// does (e.g. --altimeter=29.92inHg), and returns a pointer to its value.
+func PressureFlag(fs *flag.FlagSet, name string, def Pressure, usage string) *Pressure {
+	v := def
+	fs.Var(&v, name, usage)
+	return &v
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestLengthJSONRoundTrip(t *testing.T) {
+	type config struct {
+		Altitude Length `json:"altitude"`
+	}
+	in := config{Altitude: 29031 * Foot}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal(%+v) failed: %v", in, err)
+	}
+
+	var out config
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed: %v", data, err)
+	}
+	// Compare the field as a plain float64: cmpopts.EquateApprox only
+	// special-cases the float64 and float32 kinds, and Length's underlying
+	// type isn't assignable to float64, so comparing the struct directly
+	// would silently fall back to exact equality and miss the last-ULP
+	// error that formatting to a fixed number of significant digits and
+	// re-parsing can introduce.
+	if !cmp.Equal(float64(out.Altitude), float64(in.Altitude), cmpopts.EquateApprox(lengthEpsilon, lengthEpsilon)) {
+		t.Errorf("round trip of %+v through JSON = %+v", in, out)
+	}
+}
+
+func TestLengthJSONBareNumber(t *testing.T) {
+	var l Length
+	if err := json.Unmarshal([]byte("150"), &l); err != nil {
+		t.Fatalf("json.Unmarshal(\"150\", &l) failed: %v", err)
+	}
+	if got, want := l, 150*Meter; got != want {
+		t.Errorf("Length from bare JSON number = %#v, want %#v", got, want)
+	}
+}
+
+func TestSetJSONNumericUnit(t *testing.T) {
+	t.Cleanup(func() { SetJSONNumericUnit[Length](Meter) })
+	SetJSONNumericUnit[Length](Kilometer)
+
+	var l Length
+	if err := json.Unmarshal([]byte("5"), &l); err != nil {
+		t.Fatalf("json.Unmarshal(\"5\", &l) failed: %v", err)
+	}
+	if got, want := l, 5*Kilometer; got != want {
+		t.Errorf("Length from bare JSON number after SetJSONNumericUnit(Kilometer) = %#v, want %#v", got, want)
+	}
+
+	// Other dimensions are unaffected.
+	var m Mass
+	if err := json.Unmarshal([]byte("5"), &m); err != nil {
+		t.Fatalf("json.Unmarshal(\"5\", &m) failed: %v", err)
+	}
+	if got, want := m, 5*Kilogram; got != want {
+		t.Errorf("Mass from bare JSON number = %#v, want %#v", got, want)
+	}
+}
+
+func TestJSONMixedUnitFields(t *testing.T) {
+	type station struct {
+		Altitude Length
+		Temp     Temperature
+		Wind     Speed
+	}
+	in := station{
+		Altitude: 8848.65 * Meter,
+		Temp:     TemperatureFromDegreesCelsius(-36.5),
+		Wind:     16.5 * MeterPerSecond,
+	}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal(%+v) failed: %v", in, err)
+	}
+
+	var out station
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed: %v", data, err)
+	}
+	if !cmp.Equal(out, in, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("round trip of %+v through JSON = %+v", in, out)
+	}
+}
+
+func TestTemperatureTextRoundTrip(t *testing.T) {
+	in := TemperatureFromDegreesCelsius(15)
+	text, err := in.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() failed: %v", err)
+	}
+
+	var out Temperature
+	if err := out.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText(%q) failed: %v", text, err)
+	}
+	if !cmp.Equal(out, in, cmpopts.EquateApprox(0, tempEpsilon)) {
+		t.Errorf("round trip of %#v through text = %#v", in, out)
+	}
+}
+
+func TestSpeedSQLRoundTrip(t *testing.T) {
+	in := 20 * Knot
+	value, err := in.Value()
+	if err != nil {
+		t.Fatalf("Value() failed: %v", err)
+	}
+
+	var out Speed
+	if err := out.Scan(value); err != nil {
+		t.Fatalf("Scan(%v) failed: %v", value, err)
+	}
+	if !cmp.Equal(out, in, cmpopts.EquateApprox(0, speedEpsilon)) {
+		t.Errorf("round trip of %#v through SQL = %#v", in, out)
+	}
+
+	// A bare numeric column (e.g. a float64 or int64 in the base SI unit)
+	// should scan directly.
+	var fromFloat Speed
+	if err := fromFloat.Scan(5.0); err != nil {
+		t.Fatalf("Scan(5.0) failed: %v", err)
+	}
+	if got, want := fromFloat, 5*MeterPerSecond; got != want {
+		t.Errorf("Speed.Scan(5.0) = %#v, want %#v", got, want)
+	}
+
+	var fromNil Speed = 1 * MeterPerSecond
+	if err := fromNil.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil) failed: %v", err)
+	}
+	if fromNil != 0 {
+		t.Errorf("Speed.Scan(nil) left value at %#v, want 0", fromNil)
+	}
+}
+
+func TestAreaFlag(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	plot := AreaFlag(fs, "plot", 1*Acre, "plot size")
+	if err := fs.Parse([]string{"--plot=5acre"}); err != nil {
+		t.Fatalf("fs.Parse failed: %v", err)
+	}
+	if got, want := *plot, 5*Acre; got != want {
+		t.Errorf("--plot=5acre parsed to %#v, want %#v", got, want)
+	}
+}
+
+func TestMassVolumePressureTextRoundTrip(t *testing.T) {
+	mass := 150 * Pound
+	massText, err := mass.MarshalText()
+	if err != nil {
+		t.Fatalf("Mass.MarshalText() failed: %v", err)
+	}
+	var mass2 Mass
+	if err := mass2.UnmarshalText(massText); err != nil {
+		t.Fatalf("Mass.UnmarshalText(%q) failed: %v", massText, err)
+	}
+	if !cmp.Equal(mass2, mass, cmpopts.EquateApprox(0, massEpsilon)) {
+		t.Errorf("round trip of %#v through text = %#v", mass, mass2)
+	}
+
+	volume := 2 * USGallon
+	volumeText, err := volume.MarshalText()
+	if err != nil {
+		t.Fatalf("Volume.MarshalText() failed: %v", err)
+	}
+	var volume2 Volume
+	if err := volume2.UnmarshalText(volumeText); err != nil {
+		t.Fatalf("Volume.UnmarshalText(%q) failed: %v", volumeText, err)
+	}
+	if !cmp.Equal(volume2, volume, cmpopts.EquateApprox(0, volumeEpsilon)) {
+		t.Errorf("round trip of %#v through text = %#v", volume, volume2)
+	}
+
+	pressure := 1013.25 * Hectopascal
+	pressureText, err := pressure.MarshalText()
+	if err != nil {
+		t.Fatalf("Pressure.MarshalText() failed: %v", err)
+	}
+	var pressure2 Pressure
+	if err := pressure2.UnmarshalText(pressureText); err != nil {
+		t.Fatalf("Pressure.UnmarshalText(%q) failed: %v", pressureText, err)
+	}
+	if !cmp.Equal(pressure2, pressure, cmpopts.EquateApprox(0, pressureEpsilon)) {
+		t.Errorf("round trip of %#v through text = %#v", pressure, pressure2)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import "fmt"
+
+// Force represents a push or pull on an object in newtons as a float64.
+type Force float64
+
+// Common force units.
+const (
+	Newton     Force = 1
+	PoundForce       = 4.4482216152605 * Newton
+)
+
+// Newtons returns the force in newtons.
+func (f Force) Newtons() float64 { return float64(f) }
+
+// PoundsForce returns the force in pounds-force.
+func (f Force) PoundsForce() float64 { return float64(f / PoundForce) }
+
+// Over returns the pressure exerted by a force f over an area a.
+func (f Force) Over(a Area) Pressure {
+	return Pressure(f.Newtons() / a.SquareMeters())
+}
+
+// String returns a string representation of the force in newtons using
+// compact number syntax, e.g. "1 N", "4.4482216152605 N".
+func (f Force) String() string {
+	return fmt.Sprintf("%g N", f.Newtons())
+}
+
+// GoString returns a Go syntax expression of the force, e.g. "1 * Newton".
+func (f Force) GoString() string {
+	return fmt.Sprintf("%g * Newton", f.Newtons())
+}
@@ -0,0 +1,216 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// formatSignificant formats v with up to 15 significant decimal digits, the
+// most a float64 reliably carries, rather than %v/%g's shortest-digit-count
+// that round-trips the exact bit pattern. Dividing a quantity by a derived
+// unit scale (e.g. rendering 500 * Milliliter in milliliters) combines two
+// independently rounded float64s and can leave noise beyond that many
+// digits (500.00000000000006); this discards it without discarding real
+// precision.
+//
+// The rounded value is re-parsed and re-formatted with %v so that, unlike a
+// bare "%.15g", the usual decimal/scientific notation switch is still based
+// on the rounded value's natural magnitude rather than on the fixed 15-digit
+// precision (which would otherwise render e.g. 1.57e+07 as "15700000").
+func formatSignificant(v float64) string {
+	rounded, err := strconv.ParseFloat(fmt.Sprintf("%.15g", v), 64)
+	if err != nil {
+		return fmt.Sprintf("%g", v)
+	}
+	return fmt.Sprintf("%v", rounded)
+}
+
+// formatState renders a quantity to satisfy fmt.Formatter. str and goStr
+// produce the %v/%s and %#v representations respectively (ordinarily a
+// type's String and GoString methods); base is the quantity's value in its
+// dimension's base unit, used for the numeric verbs %g, %e, and %f.
+func formatState(f fmt.State, verb rune, base float64, str, goStr func() string) {
+	switch verb {
+	case 'v':
+		if f.Flag('#') {
+			fmt.Fprint(f, goStr())
+			return
+		}
+		fmt.Fprint(f, str())
+	case 's':
+		fmt.Fprint(f, str())
+	case 'g', 'G', 'e', 'E', 'f', 'F':
+		fmt.Fprintf(f, verbSpec(f, verb), base)
+	default:
+		fmt.Fprintf(f, "%%!%c(BADVERB)", verb)
+	}
+}
+
+// verbSpec rebuilds a printf verb (e.g. "%+08.2f") from the flags, width,
+// and precision that fmt.State exposes for the current call.
+func verbSpec(f fmt.State, verb rune) string {
+	var b strings.Builder
+	b.WriteByte('%')
+	for _, flag := range "-+ 0#" {
+		if f.Flag(int(flag)) {
+			b.WriteRune(flag)
+		}
+	}
+	if w, ok := f.Width(); ok {
+		fmt.Fprintf(&b, "%d", w)
+	}
+	if p, ok := f.Precision(); ok {
+		b.WriteByte('.')
+		fmt.Fprintf(&b, "%d", p)
+	}
+	b.WriteRune(verb)
+	return b.String()
+}
+
+// layoutRE splits a Formatf layout, such as "%.1f km", into its printf verb
+// ("%.1f") and the remainder of the layout, which names the target unit and
+// is echoed back verbatim ( " km").
+var layoutRE = regexp.MustCompile(`^(%[-+ 0#]*\d*\.?\d*[a-zA-Z])(.*)$`)
+
+// unitValueIn returns base, converted to the unit named by token, for one of
+// the dimensions registered in registries. Temperature is handled
+// separately by Temperature.Formatf, since its conversions are affine
+// rather than pure scale factors.
+func unitValueIn(t reflect.Type, base float64, token string) (float64, bool) {
+	token = strings.TrimSpace(token)
+	for _, entry := range registries[t] {
+		if entry.symbol == token {
+			return base / entry.scale, true
+		}
+		for _, alias := range entry.wordAliases {
+			if strings.EqualFold(alias, token) {
+				return base / entry.scale, true
+			}
+		}
+		if entry.siPrefixable {
+			for _, p := range siPrefixes {
+				if p.symbol+entry.symbol == token {
+					return base / (entry.scale * entry.prefixScale(p)), true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+// formatf implements the Formatf mini-language shared by Length, Area, and
+// Speed: a printf verb for the number, followed by the name of the target
+// unit, e.g. "%.1f km" or "%.3g ha".
+func formatf(t reflect.Type, base float64, layout string) string {
+	m := layoutRE.FindStringSubmatch(layout)
+	if m == nil {
+		return fmt.Sprintf("%%!Formatf(invalid layout %q)", layout)
+	}
+	verb, rest := m[1], m[2]
+	value, ok := unitValueIn(t, base, rest)
+	if !ok {
+		return fmt.Sprintf("%%!Formatf(unknown unit %q)", rest)
+	}
+	return fmt.Sprintf(verb, value) + rest
+}
+
+// LengthSystem selects the family of units Length.Render renders into.
+type LengthSystem int
+
+const (
+	// AutoSystem, the default, reproduces Length.String's behavior: the
+	// metric unit (km, m, cm, mm, or µm) with the most readable magnitude.
+	AutoSystem LengthSystem = iota
+	// MetricSystem always renders in meters.
+	MetricSystem
+	// ImperialSystem always renders in feet.
+	ImperialSystem
+	// NauticalSystem always renders in nautical miles.
+	NauticalSystem
+)
+
+// PrecisionMode selects how FormatOptions.Precision is interpreted.
+type PrecisionMode int
+
+const (
+	// DefaultPrecision, the zero value, renders up to 15 significant
+	// digits, like formatSignificant, rather than %g's shortest decimal
+	// that round-trips the exact bit pattern.
+	DefaultPrecision PrecisionMode = iota
+	// SignificantDigits interprets Precision as a number of significant
+	// digits, like the %g verb.
+	SignificantDigits
+	// FixedDecimals interprets Precision as a number of digits after the
+	// decimal point, like the %f verb.
+	FixedDecimals
+)
+
+// SymbolStyle selects how Temperature.Render spells its scale's symbol.
+type SymbolStyle int
+
+const (
+	// DegreeSymbol, the default, uses the degree sign, e.g. "°C".
+	DegreeSymbol SymbolStyle = iota
+	// WordSymbol spells out "deg" instead of the degree sign, e.g. "degC".
+	WordSymbol
+	// BareSymbol omits the degree sign entirely, e.g. "C".
+	BareSymbol
+)
+
+// FormatOptions controls the output of Temperature.Render and Length.Render.
+// The zero value renders with default precision and styling.
+type FormatOptions struct {
+	// Scale selects the target scale for Temperature.Render; ignored by
+	// Length.Render.
+	Scale TemperatureScale
+	// System selects the target unit family for Length.Render; ignored by
+	// Temperature.Render.
+	System LengthSystem
+	// PrecisionMode selects how Precision is interpreted.
+	PrecisionMode PrecisionMode
+	// Precision is the number of digits rendered, interpreted according to
+	// PrecisionMode; it is ignored when PrecisionMode is DefaultPrecision.
+	Precision int
+	// ShowSign adds a leading '+' for positive values, like the %+ printf
+	// flag.
+	ShowSign bool
+	// Symbol selects how Temperature.Render spells its scale's symbol;
+	// ignored by Length.Render.
+	Symbol SymbolStyle
+}
+
+// precisionVerb builds a printf numeric verb, such as "%.2f" or "%+g", from
+// opts' PrecisionMode, Precision, and ShowSign.
+func precisionVerb(opts FormatOptions) string {
+	var b strings.Builder
+	b.WriteByte('%')
+	if opts.ShowSign {
+		b.WriteByte('+')
+	}
+	switch opts.PrecisionMode {
+	case FixedDecimals:
+		fmt.Fprintf(&b, ".%df", opts.Precision)
+	case SignificantDigits:
+		fmt.Fprintf(&b, ".%dg", opts.Precision)
+	default:
+		b.WriteString(".15g")
+	}
+	return b.String()
+}
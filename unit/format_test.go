@@ -0,0 +1,171 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLengthFormatVerbs(t *testing.T) {
+	l := 1500 * Meter
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%v", l.String()},
+		{"%s", l.String()},
+		{"%#v", l.GoString()},
+		{"%g", "1500"},
+		{"%.2f", "1500.00"},
+		{"%+f", "+1500.000000"},
+		{"%10.1f", "    1500.0"},
+	}
+	for _, test := range tests {
+		if got, want := fmt.Sprintf(test.format, l), test.want; got != want {
+			t.Errorf("fmt.Sprintf(%q, %#v) = %q, want %q", test.format, l, got, want)
+		}
+	}
+}
+
+func TestAreaFormatVerbs(t *testing.T) {
+	a := 10 * SquareMeter
+	if got, want := fmt.Sprintf("%v", a), a.String(); got != want {
+		t.Errorf("fmt.Sprintf(%%v, %#v) = %q, want %q", a, got, want)
+	}
+	if got, want := fmt.Sprintf("%#v", a), a.GoString(); got != want {
+		t.Errorf("fmt.Sprintf(%%#v, %#v) = %q, want %q", a, got, want)
+	}
+	if got, want := fmt.Sprintf("%.1f", a), "10.0"; got != want {
+		t.Errorf("fmt.Sprintf(%%.1f, %#v) = %q, want %q", a, got, want)
+	}
+}
+
+func TestSpeedFormatVerbs(t *testing.T) {
+	s := 10 * MeterPerSecond
+	if got, want := fmt.Sprintf("%v", s), s.String(); got != want {
+		t.Errorf("fmt.Sprintf(%%v, %#v) = %q, want %q", s, got, want)
+	}
+	if got, want := fmt.Sprintf("%.1f", s), "10.0"; got != want {
+		t.Errorf("fmt.Sprintf(%%.1f, %#v) = %q, want %q", s, got, want)
+	}
+}
+
+func TestTemperatureFormatVerbs(t *testing.T) {
+	tp := 294.15 * Kelvin
+	if got, want := fmt.Sprintf("%v", tp), tp.String(); got != want {
+		t.Errorf("fmt.Sprintf(%%v, %#v) = %q, want %q", tp, got, want)
+	}
+	if got, want := fmt.Sprintf("%#v", tp), tp.GoString(); got != want {
+		t.Errorf("fmt.Sprintf(%%#v, %#v) = %q, want %q", tp, got, want)
+	}
+	if got, want := fmt.Sprintf("%.2f", tp), "294.15"; got != want {
+		t.Errorf("fmt.Sprintf(%%.2f, %#v) = %q, want %q", tp, got, want)
+	}
+}
+
+func TestFormatf(t *testing.T) {
+	l := 500 * Mile
+	if got, want := l.Formatf("%.1f km"), "804.7 km"; got != want {
+		t.Errorf("l.Formatf(%q) = %q, want %q", "%.1f km", got, want)
+	}
+
+	a := 2.5899881103360003 * SquareKilometer
+	if got, want := a.Formatf("%.3g ha"), "259 ha"; got != want {
+		t.Errorf("a.Formatf(%q) = %q, want %q", "%.3g ha", got, want)
+	}
+
+	tp := TemperatureFromDegreesCelsius(15)
+	if got, want := tp.Formatf("%.1f °C"), "15.0 °C"; got != want {
+		t.Errorf("tp.Formatf(%q) = %q, want %q", "%.1f °C", got, want)
+	}
+
+	s := 20 * Knot
+	if got, want := s.Formatf("%.2f kts"), fmt.Sprintf("%.2f kts", 20.0); got != want {
+		t.Errorf("s.Formatf(%q) = %q, want %q", "%.2f kts", got, want)
+	}
+}
+
+func TestTemperatureFormatCVerb(t *testing.T) {
+	tp := TemperatureFromDegreesCelsius(15)
+	if got, want := fmt.Sprintf("%.2C", tp), "15.00°C"; got != want {
+		t.Errorf("fmt.Sprintf(%%.2C, %#v) = %q, want %q", tp, got, want)
+	}
+	if got, want := fmt.Sprintf("%+.1C", tp), "+15.0°C"; got != want {
+		t.Errorf("fmt.Sprintf(%%+.1C, %#v) = %q, want %q", tp, got, want)
+	}
+}
+
+func TestTemperatureRender(t *testing.T) {
+	tp := TemperatureFromDegreesCelsius(15)
+	tests := []struct {
+		tp   Temperature
+		opts FormatOptions
+		want string
+	}{
+		{tp, FormatOptions{}, "15°C"},
+		{tp, FormatOptions{Scale: KelvinScale}, "288.15K"},
+		{tp, FormatOptions{Scale: KelvinScale, Symbol: WordSymbol}, "288.15K"},
+		{tp, FormatOptions{PrecisionMode: FixedDecimals, Precision: 1}, "15.0°C"},
+		{tp, FormatOptions{PrecisionMode: SignificantDigits, Precision: 2}, "15°C"},
+		{tp, FormatOptions{ShowSign: true}, "+15°C"},
+		{TemperatureFromDegreesFahrenheit(0), FormatOptions{Scale: FahrenheitScale}, "0°F"},
+		{TemperatureFromDegreesFahrenheit(0), FormatOptions{Scale: FahrenheitScale, Symbol: WordSymbol}, "0degF"},
+		{TemperatureFromDegreesFahrenheit(0), FormatOptions{Scale: FahrenheitScale, Symbol: BareSymbol}, "0F"},
+	}
+	for _, test := range tests {
+		if got := test.tp.Render(test.opts); got != test.want {
+			t.Errorf("%#v.Render(%+v) = %q, want %q", test.tp, test.opts, got, test.want)
+		}
+	}
+
+	if got, want := temperatureFromKelvin(5778).Render(FormatOptions{}), "5778K"; got != want {
+		t.Errorf("temperatureFromKelvin(5778).Render(FormatOptions{}) = %q, want %q (outside AutoScale's Celsius range)", got, want)
+	}
+}
+
+func TestLengthRender(t *testing.T) {
+	tests := []struct {
+		l    Length
+		opts FormatOptions
+		want string
+	}{
+		{29031 * Foot, FormatOptions{System: ImperialSystem}, "29031ft"},
+		{29031 * Foot, FormatOptions{System: MetricSystem, PrecisionMode: FixedDecimals, Precision: 2}, "8848.65m"},
+		{5 * Kilometer, FormatOptions{}, "5km"},
+		{20 * NauticalMile, FormatOptions{System: NauticalSystem}, "20nmi"},
+		{1500 * Meter, FormatOptions{ShowSign: true}, "+1.5km"},
+	}
+	for _, test := range tests {
+		if got := test.l.Render(test.opts); got != test.want {
+			t.Errorf("%#v.Render(%+v) = %q, want %q", test.l, test.opts, got, test.want)
+		}
+	}
+}
+
+func TestStringImperial(t *testing.T) {
+	if got, want := (500 * Mile).StringImperial(), "500mi"; got != want {
+		t.Errorf("(500 * Mile).StringImperial() = %q, want %q", got, want)
+	}
+	if got, want := (10 * Foot).StringImperial(), "10ft"; got != want {
+		t.Errorf("(10 * Foot).StringImperial() = %q, want %q", got, want)
+	}
+	if got, want := (5 * SquareMile).StringImperial(), "5sq mi"; got != want {
+		t.Errorf("(5 * SquareMile).StringImperial() = %q, want %q", got, want)
+	}
+	if got, want := (60 * MilePerHour).StringImperial(), "60 mph"; got != want {
+		t.Errorf("(60 * MilePerHour).StringImperial() = %q, want %q", got, want)
+	}
+}
@@ -16,6 +16,7 @@ package unit
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 )
 
@@ -121,9 +122,86 @@ func (l Length) format() (string, unitDesc) {
 	}
 	for _, unitThreshold := range unitThresholds {
 		if l.Abs() >= unitThreshold.length {
-			return fmt.Sprintf("%v", float64(l/unitThreshold.length)), unitThreshold
+			return formatSignificant(float64(l / unitThreshold.length)), unitThreshold
 		}
 	}
 	// %g instead of %e for variable precision
 	return fmt.Sprintf("%g", l/meterDesc.length), meterDesc
 }
+
+var (
+	mileDesc = unitDesc{Mile, "Mile", "mi"}
+	footDesc = unitDesc{Foot, "Foot", "ft"}
+	inchDesc = unitDesc{Inch, "Inch", "in"}
+
+	// imperialLengthThresholds mirrors unitThresholds, but for the
+	// mile/foot/inch family used by StringImperial.
+	imperialLengthThresholds = []unitDesc{
+		// NOTE: keep in descending order so that imperialFormat() works correctly.
+		mileDesc,
+		footDesc,
+		inchDesc,
+	}
+)
+
+func (l Length) imperialFormat() (string, unitDesc) {
+	if l.Abs() >= 1e6*mileDesc.length {
+		return fmt.Sprintf("%g", l/mileDesc.length), mileDesc
+	}
+	for _, u := range imperialLengthThresholds {
+		if l.Abs() >= u.length {
+			return formatSignificant(float64(l / u.length)), u
+		}
+	}
+	return fmt.Sprintf("%g", l/inchDesc.length), inchDesc
+}
+
+// StringImperial returns a string representation of the length like String,
+// but preferring the mile/foot/inch family of units over the metric one.
+func (l Length) StringImperial() string {
+	value, desc := l.imperialFormat()
+	return fmt.Sprintf("%v%v", value, desc.symbol)
+}
+
+// Format implements fmt.Formatter. %v and %s render as String does; %#v
+// renders as GoString does; %g, %e, and %f (and their upper-case forms)
+// render the length in meters honoring the usual flags, width, and
+// precision.
+func (l Length) Format(f fmt.State, verb rune) {
+	formatState(f, verb, l.Meters(), l.String, l.GoString)
+}
+
+// Formatf renders the length using a layout combining a printf numeric verb
+// with the name of the target unit, e.g. l.Formatf("%.1f km").
+func (l Length) Formatf(layout string) string {
+	return formatf(reflect.TypeOf(l), l.Meters(), layout)
+}
+
+// systemValueAndSymbol returns l's value and unit symbol in the family
+// selected by system. AutoSystem resolves the same way String does; the
+// other systems each render in a single fixed unit so that Render's output
+// doesn't jump between units as the value crosses a threshold.
+func (l Length) systemValueAndSymbol(system LengthSystem) (float64, string) {
+	switch system {
+	case MetricSystem:
+		return l.Meters(), "m"
+	case ImperialSystem:
+		return l.Feet(), "ft"
+	case NauticalSystem:
+		return l.NauticalMiles(), "nmi"
+	default:
+		_, desc := l.format()
+		return float64(l / desc.length), desc.symbol
+	}
+}
+
+// Render formats the length according to opts, selecting a target unit
+// family, precision, and sign, e.g.
+//
+//	(29031 * Foot).Render(FormatOptions{System: ImperialSystem}) // "29031ft"
+//
+// The zero FormatOptions renders in AutoSystem with default precision.
+func (l Length) Render(opts FormatOptions) string {
+	value, symbol := l.systemValueAndSymbol(opts.System)
+	return fmt.Sprintf(precisionVerb(opts), value) + symbol
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import "fmt"
+
+// Mass represents an amount of matter in kilograms as a float64.
+type Mass float64
+
+// Common mass units.
+const (
+	Kilogram  Mass = 1
+	Gram           = 1e-3 * Kilogram
+	Milligram      = 1e-3 * Gram
+	MetricTon      = 1e3 * Kilogram
+	Pound          = 0.45359237 * Kilogram
+	Ounce          = Pound / 16
+	Stone          = 14 * Pound
+)
+
+// Abs returns the mass as an absolute value.
+func (m Mass) Abs() Mass {
+	if m < 0 {
+		return -m
+	}
+	return m
+}
+
+// Kilograms returns the mass in kilograms.
+func (m Mass) Kilograms() float64 { return float64(m) }
+
+// Grams returns the mass in grams.
+func (m Mass) Grams() float64 { return float64(m / Gram) }
+
+// Milligrams returns the mass in milligrams.
+func (m Mass) Milligrams() float64 { return float64(m / Milligram) }
+
+// MetricTons returns the mass in metric tons.
+func (m Mass) MetricTons() float64 { return float64(m / MetricTon) }
+
+// Pounds returns the mass in avoirdupois pounds.
+func (m Mass) Pounds() float64 { return float64(m / Pound) }
+
+// Ounces returns the mass in avoirdupois ounces.
+func (m Mass) Ounces() float64 { return float64(m / Ounce) }
+
+// Stones returns the mass in stones.
+func (m Mass) Stones() float64 { return float64(m / Stone) }
+
+// Over returns the density of a body with mass m and volume v.
+func (m Mass) Over(v Volume) Density {
+	return Density(m.Kilograms() / v.CubicMeters())
+}
+
+// String returns a string representation of the mass in kilograms.
+//
+// If possible, the mass will be returned with an appropriate SI prefix
+// (e.g. 1.2t, 2.3kg, 3.4g, 4.5mg), otherwise the mass will be returned as a
+// scientific representation in kilograms (e.g. 1.496e+11kg).
+func (m Mass) String() string {
+	value, desc := m.format()
+	return fmt.Sprintf("%v%v", value, desc.symbol)
+}
+
+func (m Mass) GoString() string {
+	value, desc := m.format()
+	return fmt.Sprintf("%v * %v", value, desc.name)
+}
+
+type massUnitDesc struct {
+	mass   Mass
+	name   string
+	symbol string
+}
+
+var (
+	metricTonDesc = massUnitDesc{MetricTon, "MetricTon", "t"}
+	kilogramDesc  = massUnitDesc{Kilogram, "Kilogram", "kg"}
+
+	// massUnitThresholds contains the thresholds for SI prefixed mass values
+	// to be used when returning a string representation of the mass.
+	massUnitThresholds = []massUnitDesc{
+		// NOTE: keep in descending order so that format() works correctly.
+		metricTonDesc,
+		kilogramDesc,
+		{Gram, "Gram", "g"},
+		{Milligram, "Milligram", "mg"},
+	}
+)
+
+func (m Mass) format() (string, massUnitDesc) {
+	if m.Abs() >= 1000*metricTonDesc.mass {
+		return fmt.Sprintf("%g", m/kilogramDesc.mass), kilogramDesc
+	}
+	for _, u := range massUnitThresholds {
+		if m.Abs() >= u.mass {
+			return formatSignificant(float64(m / u.mass)), u
+		}
+	}
+	return fmt.Sprintf("%g", m/kilogramDesc.mass), kilogramDesc
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+const massEpsilon = 1e-9
+
+func TestEmptyMass(t *testing.T) {
+	var m Mass
+	if kg := m.Kilograms(); kg != 0 {
+		t.Errorf("Empty value of Mass was %v, want 0", kg)
+	}
+}
+
+func TestMassConversion(t *testing.T) {
+	units := []struct {
+		name    string
+		unit    Mass
+		convert func(Mass) float64
+	}{
+		{"MetricTon", MetricTon, Mass.MetricTons},
+		{"Gram", Gram, Mass.Grams},
+		{"Milligram", Milligram, Mass.Milligrams},
+		{"Pound", Pound, Mass.Pounds},
+		{"Ounce", Ounce, Mass.Ounces},
+		{"Stone", Stone, Mass.Stones},
+	}
+	for _, u := range units {
+		if got := u.convert(u.unit); !cmp.Equal(got, 1.0, cmpopts.EquateApprox(0, massEpsilon)) {
+			t.Errorf("(1 * %v).%v() = %v, want 1", u.name, u.name, got)
+		}
+	}
+
+	// One stone is exactly 14 pounds.
+	if got, want := Stone.Pounds(), 14.0; !cmp.Equal(got, want, cmpopts.EquateApprox(0, massEpsilon)) {
+		t.Errorf("Stone.Pounds() = %v, want %v", got, want)
+	}
+}
+
+func TestMassString(t *testing.T) {
+	tests := []struct {
+		m Mass
+		s string
+	}{
+		{0 * Kilogram, "0kg"},
+		{1 * Kilogram, "1kg"},
+		{1 * MetricTon, "1t"},
+		{500 * Gram, "500g"},
+		{5 * Milligram, "5mg"},
+		{1e7 * Kilogram, "1e+07kg"},
+	}
+	for _, test := range tests {
+		if got, want := test.m.String(), test.s; got != want {
+			t.Errorf("(%#v).String() = %#v, want %#v", test.m, got, want)
+		}
+	}
+}
+
+func TestMassOverVolume(t *testing.T) {
+	got := (1000 * Kilogram).Over(1 * CubicMeter)
+	want := Density(1000)
+	if !cmp.Equal(got, want, cmpopts.EquateApprox(0, massEpsilon)) {
+		t.Errorf("(1000 * Kilogram).Over(1 * CubicMeter) = %#v, want %#v", got, want)
+	}
+}
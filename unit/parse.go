@@ -0,0 +1,326 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// siPrefix describes a metric prefix that can be combined with a unit's base
+// symbol to form compound spellings such as "km" or "µm".
+type siPrefix struct {
+	name   string
+	symbol string
+	scale  float64
+}
+
+// siPrefixes lists the metric prefixes Parse understands when matching a
+// unit that was registered as SI-prefixable. Both "µ" and the ASCII-friendly
+// "u" are accepted for micro.
+var siPrefixes = []siPrefix{
+	{"nano", "n", 1e-9},
+	{"micro", "µ", 1e-6},
+	{"micro", "u", 1e-6},
+	{"milli", "m", 1e-3},
+	{"centi", "c", 1e-2},
+	{"deci", "d", 1e-1},
+	{"", "", 1},
+	{"kilo", "k", 1e3},
+	{"mega", "M", 1e6},
+	{"giga", "G", 1e9},
+	{"tera", "T", 1e12},
+}
+
+// unitSpelling is one registered unit: a scale relative to the dimension's
+// base unit (e.g. Meter, SquareMeter), plus every string Parse should accept
+// for it. symbol is matched case-sensitively, since SI prefixes and base
+// symbols collide under case-folding (e.g. "M" for mega vs. "m" for milli);
+// wordAliases (the registered name, plus any spelled-out aliases) are
+// matched case-insensitively. If siPrefixable is set, symbol is additionally
+// combined with every entry in siPrefixes (e.g. "m" -> "km", "µm", "nm", ...).
+type unitSpelling struct {
+	scale        float64
+	symbol       string
+	wordAliases  []string
+	siPrefixable bool
+}
+
+// prefixScale returns the factor prefix p contributes to u's scale. For a
+// squared or cubed symbol the prefix applies to the base length inside the
+// power (e.g. "km^2" is (1e3 m)^2), so its scale is raised to that power.
+func (u unitSpelling) prefixScale(p siPrefix) float64 {
+	switch {
+	case strings.HasSuffix(u.symbol, "^2"):
+		return p.scale * p.scale
+	case strings.HasSuffix(u.symbol, "^3"):
+		return p.scale * p.scale * p.scale
+	}
+	return p.scale
+}
+
+// registries holds the known spellings for each registered dimension,
+// keyed by the dimension's reflect.Type (e.g. reflect.TypeOf(Length(0))).
+var registries = map[reflect.Type][]unitSpelling{}
+
+// RegisterUnit adds a new recognized spelling for the dimension T, so that
+// Parse (and the ParseXxx helpers, for the built-in dimensions)
+// can decode it. scale is the size of one unit expressed in T's base unit
+// (e.g. for a Length, in meters). This lets downstream packages teach Parse
+// about domain-specific units, such as survey feet or US gallons, without
+// forking this package.
+func RegisterUnit[T ~float64](name, symbol string, scale T, aliases ...string) {
+	registerUnit[T](name, symbol, float64(scale), false, aliases...)
+}
+
+// registerUnit is the internal counterpart of RegisterUnit used for the
+// built-in units, which additionally marks SI-prefixable base units.
+func registerUnit[T ~float64](name, symbol string, scale float64, siPrefixable bool, aliases ...string) {
+	t := reflect.TypeOf(T(0))
+	registries[t] = append(registries[t], unitSpelling{
+		scale:        scale,
+		symbol:       symbol,
+		wordAliases:  append([]string{name}, aliases...),
+		siPrefixable: siPrefixable,
+	})
+}
+
+func init() {
+	registerUnit[Length]("Meter", "m", float64(Meter), true)
+	registerUnit[Length]("Foot", "ft", float64(Foot), false, "foot", "feet")
+	registerUnit[Length]("Mile", "mi", float64(Mile), false, "mile", "miles")
+	registerUnit[Length]("Inch", "in", float64(Inch), false, "inch", "inches", "\"")
+	registerUnit[Length]("NauticalMile", "nmi", float64(NauticalMile), false, "nautical mile", "nautical miles")
+
+	registerUnit[Area]("SquareMeter", "m^2", float64(SquareMeter), true, "m2", "sq m")
+	registerUnit[Area]("Hectare", "ha", float64(Hectare), false, "hectare", "hectares")
+	registerUnit[Area]("SquareFoot", "sq ft", float64(SquareFoot), false, "ft^2", "ft2", "square foot", "square feet")
+	registerUnit[Area]("SquareMile", "sq mi", float64(SquareMile), false, "mi^2", "mi2", "square mile", "square miles")
+	registerUnit[Area]("Acre", "acre", float64(Acre), false, "acres")
+	registerUnit[Area]("SquareInch", "sq in", float64(SquareInch), false, "in^2", "in2", "in²", "square inch", "square inches")
+
+	registerUnit[Speed]("MeterPerSecond", "m/s", float64(MeterPerSecond), false, "mps")
+	registerUnit[Speed]("KilometerPerHour", "km/h", float64(KilometerPerHour), false, "kph")
+	registerUnit[Speed]("MilePerHour", "mph", float64(MilePerHour), false)
+	registerUnit[Speed]("FootPerSecond", "ft/s", float64(FootPerSecond), false, "fps")
+	registerUnit[Speed]("Knot", "kt", float64(Knot), false, "kts", "knot", "knots")
+
+	registerUnit[Volume]("CubicMeter", "m^3", float64(CubicMeter), true, "m3")
+	registerUnit[Volume]("Liter", "L", float64(Liter), false, "liter", "liters")
+	registerUnit[Volume]("Milliliter", "mL", float64(Milliliter), false, "milliliter", "milliliters")
+	registerUnit[Volume]("CubicFoot", "ft^3", float64(CubicFoot), false, "ft3", "cubic foot", "cubic feet")
+	registerUnit[Volume]("CubicInch", "in^3", float64(CubicInch), false, "in3", "cubic inch", "cubic inches")
+	registerUnit[Volume]("USGallon", "gal", float64(USGallon), false, "US gallon", "US gallons", "gallon", "gallons")
+	registerUnit[Volume]("ImperialGallon", "impgal", float64(ImperialGallon), false, "imperial gallon", "imperial gallons")
+	registerUnit[Volume]("USFluidOunce", "fl oz", float64(USFluidOunce), false, "US fluid ounce", "US fluid ounces")
+
+	registerUnit[Mass]("Kilogram", "kg", float64(Kilogram), false)
+	registerUnit[Mass]("Gram", "g", float64(Gram), false, "gram", "grams")
+	registerUnit[Mass]("Milligram", "mg", float64(Milligram), false, "milligram", "milligrams")
+	registerUnit[Mass]("MetricTon", "t", float64(MetricTon), false, "tonne", "tonnes", "metric ton", "metric tons")
+	registerUnit[Mass]("Pound", "lb", float64(Pound), false, "lbs", "pound", "pounds")
+	registerUnit[Mass]("Ounce", "oz", float64(Ounce), false, "ounce", "ounces")
+	registerUnit[Mass]("Stone", "st", float64(Stone), false, "stone", "stones")
+
+	registerUnit[Pressure]("Pascal", "Pa", float64(Pascal), true)
+	registerUnit[Pressure]("Hectopascal", "hPa", float64(Hectopascal), false)
+	registerUnit[Pressure]("Kilopascal", "kPa", float64(Kilopascal), false)
+	registerUnit[Pressure]("Bar", "bar", float64(Bar), false)
+	registerUnit[Pressure]("Millibar", "mbar", float64(Millibar), false, "mb")
+	registerUnit[Pressure]("Atmosphere", "atm", float64(Atmosphere), false)
+	registerUnit[Pressure]("PSI", "psi", float64(PSI), false)
+	registerUnit[Pressure]("InchOfMercury", "inHg", float64(InchOfMercury), false)
+	registerUnit[Pressure]("MillimeterOfMercury", "mmHg", float64(MillimeterOfMercury), false, "torr")
+}
+
+// numberRE splits a leading signed, optionally-scientific-notation number
+// from the remainder of the string, which is treated as the unit.
+var numberRE = regexp.MustCompile(`^\s*([+-]?(?:\d+\.?\d*|\.\d+)(?:[eE][+-]?\d+)?)\s*(.*?)\s*$`)
+
+// ParseError reports that a string could not be parsed as a unit value.
+type ParseError struct {
+	Value string // the original input
+	Type  string // the Go type that was requested, e.g. "Length"
+	Err   error  // the underlying reason
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("unit: cannot parse %q as %s: %v", e.Value, e.Type, e.Err)
+}
+
+func (e *ParseError) Unwrap() error { return e.Err }
+
+// Parse decodes s, a number followed by a unit symbol (e.g. "1.2km",
+// "5 sq mi", "70 °F", "20kts"), into out, which must be a pointer to a
+// registered dimension such as *Length, *Area, *Speed, or *Temperature.
+// SI-prefixed forms of a base unit (nano-, micro-, milli-, centi-, deci-,
+// kilo-, mega-, giga-, tera-) are recognized automatically.
+func Parse(s string, out any) error {
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Float64 {
+		return fmt.Errorf("unit: Parse requires a non-nil pointer to a unit type, got %T", out)
+	}
+	t := v.Elem().Type()
+	if t == reflect.TypeOf(Temperature(0)) {
+		temp, err := ParseTemperature(s)
+		if err != nil {
+			return err
+		}
+		v.Elem().SetFloat(float64(temp))
+		return nil
+	}
+	value, err := parseRegistered(t, s)
+	if err != nil {
+		return err
+	}
+	v.Elem().SetFloat(value)
+	return nil
+}
+
+func parseRegistered(t reflect.Type, s string) (float64, error) {
+	num, unit, err := splitNumberAndUnit(t.Name(), s)
+	if err != nil {
+		return 0, err
+	}
+	for _, entry := range registries[t] {
+		if entry.symbol == unit {
+			return num * entry.scale, nil
+		}
+		for _, alias := range entry.wordAliases {
+			if strings.EqualFold(alias, unit) {
+				return num * entry.scale, nil
+			}
+		}
+		if entry.siPrefixable {
+			for _, p := range siPrefixes {
+				if p.symbol+entry.symbol == unit {
+					return num * entry.scale * entry.prefixScale(p), nil
+				}
+			}
+		}
+	}
+	return 0, &ParseError{Value: s, Type: t.Name(), Err: fmt.Errorf("unknown unit %q", unit)}
+}
+
+func splitNumberAndUnit(typeName, s string) (float64, string, error) {
+	m := numberRE.FindStringSubmatch(s)
+	if m == nil {
+		return 0, "", &ParseError{Value: s, Type: typeName, Err: fmt.Errorf("missing numeric value")}
+	}
+	num, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, "", &ParseError{Value: s, Type: typeName, Err: err}
+	}
+	return num, m[2], nil
+}
+
+// ParseLength parses s, such as "1.2km" or "25 cm", into a Length. It
+// accepts the same suffixes Length.String emits ("m", "km", "cm", "mm",
+// "µm"/"um", "mi", "ft", "in", "nmi"), tolerates optional whitespace between
+// the number and the unit, and allows a leading sign or scientific
+// notation in the number (e.g. "-1.2e3m").
+func ParseLength(s string) (Length, error) {
+	var l Length
+	if err := Parse(s, &l); err != nil {
+		return 0, err
+	}
+	return l, nil
+}
+
+// ParseArea parses s, such as "5 sq mi" or "2.5ha", into an Area.
+func ParseArea(s string) (Area, error) {
+	var a Area
+	if err := Parse(s, &a); err != nil {
+		return 0, err
+	}
+	return a, nil
+}
+
+// ParseSpeed parses s, such as "20 kts" or "9.8 m/s", into a Speed.
+func ParseSpeed(s string) (Speed, error) {
+	var sp Speed
+	if err := Parse(s, &sp); err != nil {
+		return 0, err
+	}
+	return sp, nil
+}
+
+// ParseVolume parses s, such as "2L" or "5 US gallons", into a Volume.
+func ParseVolume(s string) (Volume, error) {
+	var v Volume
+	if err := Parse(s, &v); err != nil {
+		return 0, err
+	}
+	return v, nil
+}
+
+// ParseMass parses s, such as "2.5kg" or "150 lbs", into a Mass.
+func ParseMass(s string) (Mass, error) {
+	var m Mass
+	if err := Parse(s, &m); err != nil {
+		return 0, err
+	}
+	return m, nil
+}
+
+// ParsePressure parses s, such as "1013 hPa" or "29.92 inHg", into a
+// Pressure.
+func ParsePressure(s string) (Pressure, error) {
+	var p Pressure
+	if err := Parse(s, &p); err != nil {
+		return 0, err
+	}
+	return p, nil
+}
+
+// temperatureSpelling maps a set of accepted spellings to the constructor
+// that turns a bare number in that scale into a Temperature. Temperature
+// conversions are affine, not pure scale factors, so they are not modeled
+// through the registries used by the other dimensions.
+type temperatureSpelling struct {
+	aliases []string
+	from    func(float64) Temperature
+	to      func(Temperature) float64
+}
+
+var temperatureSpellings = []temperatureSpelling{
+	{[]string{"K", "°K", "Kelvin", "kelvin"}, temperatureFromKelvin, Temperature.Kelvin},
+	{[]string{"°C", "degC", "deg C", "C", "Celsius", "celsius"}, TemperatureFromDegreesCelsius, Temperature.DegreesCelsius},
+	{[]string{"°F", "degF", "deg F", "F", "Fahrenheit", "fahrenheit"}, TemperatureFromDegreesFahrenheit, Temperature.DegreesFahrenheit},
+	{[]string{"°R", "degR", "deg R", "R", "Rankine", "rankine"}, temperatureFromDegreesRankine, Temperature.DegreesRankine},
+}
+
+// ParseTemperature parses s, such as "70 °F", "294.15K", or "100degC", into
+// a Temperature. It accepts the same suffixes Temperature.String emits
+// ("K", "°C"/"degC", "°F"/"degF", "°R"/"degR"), tolerates optional
+// whitespace between the number and the unit, and allows a leading sign or
+// scientific notation in the number.
+func ParseTemperature(s string) (Temperature, error) {
+	num, unit, err := splitNumberAndUnit("Temperature", s)
+	if err != nil {
+		return 0, err
+	}
+	for _, spelling := range temperatureSpellings {
+		for _, alias := range spelling.aliases {
+			if alias == unit {
+				return spelling.from(num), nil
+			}
+		}
+	}
+	return 0, &ParseError{Value: s, Type: "Temperature", Err: fmt.Errorf("unknown unit %q", unit)}
+}
@@ -0,0 +1,230 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParseLengthRoundTrip(t *testing.T) {
+	for _, test := range stringTests {
+		t.Run(test.s, func(t *testing.T) {
+			got, err := ParseLength(test.in.String())
+			if err != nil {
+				t.Fatalf("ParseLength(%q) failed: %v", test.in.String(), err)
+			}
+			if !cmp.Equal(got, test.in, cmpopts.EquateApprox(0, lengthEpsilon)) {
+				t.Errorf("ParseLength(%q) = %#v, want %#v", test.in.String(), got, test.in)
+			}
+		})
+	}
+}
+
+func TestParseTemperatureRoundTrip(t *testing.T) {
+	for _, test := range temperatureStringTests {
+		t.Run(test.s, func(t *testing.T) {
+			got, err := ParseTemperature(test.in.String())
+			if err != nil {
+				t.Fatalf("ParseTemperature(%q) failed: %v", test.in.String(), err)
+			}
+			// Compare as plain float64s: cmpopts.EquateApprox only special-cases
+			// the float64 and float32 kinds, and Temperature's underlying type
+			// isn't assignable to float64, so comparing Temperature values
+			// directly would silently fall back to exact equality and miss the
+			// last-ULP error that formatting to a fixed number of significant
+			// digits and re-parsing can introduce.
+			if !cmp.Equal(float64(got), float64(test.in), cmpopts.EquateApprox(0, tempEpsilon)) {
+				t.Errorf("ParseTemperature(%q) = %#v, want %#v", test.in.String(), got, test.in)
+			}
+		})
+	}
+}
+
+func TestParsePrefixedLength(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Length
+	}{
+		{"2.5Mm", 2.5e6 * Meter},
+		{"1.2km", 1.2 * Kilometer},
+		{"25 cm", 25 * Centimeter},
+		{"3nm", 3 * 1e-9 * Meter},
+		{"3um", 3 * Micrometer},
+		{"3µm", 3 * Micrometer},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			got, err := ParseLength(test.in)
+			if err != nil {
+				t.Fatalf("ParseLength(%q) failed: %v", test.in, err)
+			}
+			// Compare as plain float64s: cmpopts.EquateApprox only special-cases
+			// the float64 and float32 kinds, and Length's underlying type isn't
+			// assignable to float64, so comparing Length values directly would
+			// silently fall back to exact equality and miss the last-ULP error
+			// that combining an SI prefix scale at runtime can introduce.
+			if !cmp.Equal(float64(got), float64(test.want), cmpopts.EquateApprox(0, lengthEpsilon)) {
+				t.Errorf("ParseLength(%q) = %#v, want %#v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseLengthSignsAndScientificNotation(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Length
+	}{
+		{"-1.2km", -1.2 * Kilometer},
+		{"+5m", 5 * Meter},
+		{"1.2e3m", 1200 * Meter},
+		{"-1.2e-3km", -1.2e-3 * Kilometer},
+		{"   5   m   ", 5 * Meter},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			got, err := ParseLength(test.in)
+			if err != nil {
+				t.Fatalf("ParseLength(%q) failed: %v", test.in, err)
+			}
+			if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, lengthEpsilon)) {
+				t.Errorf("ParseLength(%q) = %#v, want %#v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseAreaAliases(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Area
+	}{
+		{"5 sq mi", 5 * SquareMile},
+		{"5 square miles", 5 * SquareMile},
+		{"12 sq in", 12 * SquareInch},
+		{"12 in^2", 12 * SquareInch},
+		{"12 in²", 12 * SquareInch},
+		{"2 ha", 2 * Hectare},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			got, err := ParseArea(test.in)
+			if err != nil {
+				t.Fatalf("ParseArea(%q) failed: %v", test.in, err)
+			}
+			if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, areaEpsilon)) {
+				t.Errorf("ParseArea(%q) = %#v, want %#v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseSpeedAliases(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Speed
+	}{
+		{"20 kts", 20 * Knot},
+		{"20kts", 20 * Knot},
+		{"9.8 m/s", 9.8 * MeterPerSecond},
+		{"60 mph", 60 * MilePerHour},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			got, err := ParseSpeed(test.in)
+			if err != nil {
+				t.Fatalf("ParseSpeed(%q) failed: %v", test.in, err)
+			}
+			if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, speedEpsilon)) {
+				t.Errorf("ParseSpeed(%q) = %#v, want %#v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseTemperatureAliases(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Temperature
+	}{
+		{"70 °F", TemperatureFromDegreesFahrenheit(70)},
+		{"70degF", TemperatureFromDegreesFahrenheit(70)},
+		{"100°C", TemperatureFromDegreesCelsius(100)},
+		{"294.15 K", temperatureFromKelvin(294.15)},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			got, err := ParseTemperature(test.in)
+			if err != nil {
+				t.Fatalf("ParseTemperature(%q) failed: %v", test.in, err)
+			}
+			if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, tempEpsilon)) {
+				t.Errorf("ParseTemperature(%q) = %#v, want %#v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseUnknownUnit(t *testing.T) {
+	if _, err := ParseLength("5 parsecs"); err == nil {
+		t.Error("ParseLength(\"5 parsecs\") succeeded, want error")
+	}
+	if _, err := ParseLength("not a length"); err == nil {
+		t.Error(`ParseLength("not a length") succeeded, want error`)
+	}
+}
+
+func TestParseRequiresPointer(t *testing.T) {
+	var l Length
+	if err := Parse("1m", l); err == nil {
+		t.Error("Parse with a non-pointer succeeded, want error")
+	}
+}
+
+// SurveyFoot is a unit outside the built-in set, registered by a
+// hypothetical downstream package to demonstrate RegisterUnit.
+const SurveyFoot Length = 1200.0 / 3937.0 * Meter
+
+func TestRegisterUnit(t *testing.T) {
+	RegisterUnit[Length]("SurveyFoot", "sft", SurveyFoot, "survey foot", "survey feet")
+
+	got, err := ParseLength("5280 sft")
+	if err != nil {
+		t.Fatalf("ParseLength(\"5280 sft\") failed: %v", err)
+	}
+	want := 5280 * SurveyFoot
+	if !cmp.Equal(got, want, cmpopts.EquateApprox(0, lengthEpsilon)) {
+		t.Errorf("ParseLength(\"5280 sft\") = %#v, want %#v", got, want)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	_, err := ParseLength("5 parsecs")
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("ParseLength error %v is not a *ParseError", err)
+	}
+	if parseErr.Type != "Length" {
+		t.Errorf("ParseError.Type = %q, want %q", parseErr.Type, "Length")
+	}
+	if got := fmt.Sprintf("%v", err); got == "" {
+		t.Errorf("ParseError.Error() returned empty string")
+	}
+}
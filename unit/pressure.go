@@ -0,0 +1,116 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import "fmt"
+
+// Pressure represents a force per unit area in pascals as a float64.
+type Pressure float64
+
+// Common pressure units.
+const (
+	Pascal              Pressure = 1
+	Hectopascal                  = 1e2 * Pascal
+	Kilopascal                   = 1e3 * Pascal
+	Bar                          = 1e5 * Pascal
+	Millibar                     = Hectopascal
+	Atmosphere                   = 101325 * Pascal
+	PSI                          = 6894.757293168361 * Pascal
+	InchOfMercury                = 3386.389 * Pascal
+	MillimeterOfMercury          = 133.322387415 * Pascal
+)
+
+// Abs returns the pressure as an absolute value.
+func (p Pressure) Abs() Pressure {
+	if p < 0 {
+		return -p
+	}
+	return p
+}
+
+// Pascals returns the pressure in pascals.
+func (p Pressure) Pascals() float64 { return float64(p) }
+
+// Hectopascals returns the pressure in hectopascals.
+func (p Pressure) Hectopascals() float64 { return float64(p / Hectopascal) }
+
+// Kilopascals returns the pressure in kilopascals.
+func (p Pressure) Kilopascals() float64 { return float64(p / Kilopascal) }
+
+// Bars returns the pressure in bars.
+func (p Pressure) Bars() float64 { return float64(p / Bar) }
+
+// Millibars returns the pressure in millibars.
+func (p Pressure) Millibars() float64 { return float64(p / Millibar) }
+
+// Atmospheres returns the pressure in standard atmospheres.
+func (p Pressure) Atmospheres() float64 { return float64(p / Atmosphere) }
+
+// PSI returns the pressure in pounds per square inch.
+func (p Pressure) PSI() float64 { return float64(p / PSI) }
+
+// InchesOfMercury returns the pressure in inches of mercury at 0°C.
+func (p Pressure) InchesOfMercury() float64 { return float64(p / InchOfMercury) }
+
+// MillimetersOfMercury returns the pressure in millimeters of mercury.
+func (p Pressure) MillimetersOfMercury() float64 { return float64(p / MillimeterOfMercury) }
+
+// String returns a string representation of the pressure in pascals.
+//
+// If possible, the pressure will be returned with an appropriate SI prefix
+// (e.g. 1.2kPa, 2.3hPa, 3.4Pa), otherwise the pressure will be returned as a
+// scientific representation in pascals (e.g. 1.496e+11Pa).
+func (p Pressure) String() string {
+	value, desc := p.format()
+	return fmt.Sprintf("%v%v", value, desc.symbol)
+}
+
+func (p Pressure) GoString() string {
+	value, desc := p.format()
+	return fmt.Sprintf("%v * %v", value, desc.name)
+}
+
+type pressureUnitDesc struct {
+	pressure Pressure
+	name     string
+	symbol   string
+}
+
+var (
+	kilopascalDesc = pressureUnitDesc{Kilopascal, "Kilopascal", "kPa"}
+	pascalDesc     = pressureUnitDesc{Pascal, "Pascal", "Pa"}
+
+	// pressureUnitThresholds contains the thresholds for SI prefixed
+	// pressure values to be used when returning a string representation of
+	// the pressure.
+	pressureUnitThresholds = []pressureUnitDesc{
+		// NOTE: keep in descending order so that format() works correctly.
+		kilopascalDesc,
+		{Hectopascal, "Hectopascal", "hPa"},
+		pascalDesc,
+	}
+)
+
+func (p Pressure) format() (string, pressureUnitDesc) {
+	if p.Abs() >= 1000*kilopascalDesc.pressure {
+		return fmt.Sprintf("%g", p/pascalDesc.pressure), pascalDesc
+	}
+	for _, u := range pressureUnitThresholds {
+		if p.Abs() >= u.pressure {
+			return formatSignificant(float64(p / u.pressure)), u
+		}
+	}
+	return fmt.Sprintf("%g", p/pascalDesc.pressure), pascalDesc
+}
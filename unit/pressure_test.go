@@ -0,0 +1,85 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+const pressureEpsilon = 1e-9
+
+func TestEmptyPressure(t *testing.T) {
+	var p Pressure
+	if pa := p.Pascals(); pa != 0 {
+		t.Errorf("Empty value of Pressure was %v, want 0", pa)
+	}
+}
+
+func TestPressureConversion(t *testing.T) {
+	units := []struct {
+		name    string
+		unit    Pressure
+		convert func(Pressure) float64
+	}{
+		{"Hectopascal", Hectopascal, Pressure.Hectopascals},
+		{"Kilopascal", Kilopascal, Pressure.Kilopascals},
+		{"Bar", Bar, Pressure.Bars},
+		{"Millibar", Millibar, Pressure.Millibars},
+		{"Atmosphere", Atmosphere, Pressure.Atmospheres},
+		{"PSI", PSI, Pressure.PSI},
+		{"InchOfMercury", InchOfMercury, Pressure.InchesOfMercury},
+		{"MillimeterOfMercury", MillimeterOfMercury, Pressure.MillimetersOfMercury},
+	}
+	for _, u := range units {
+		if got := u.convert(u.unit); !cmp.Equal(got, 1.0, cmpopts.EquateApprox(0, pressureEpsilon)) {
+			t.Errorf("(1 * %v).%v() = %v, want 1", u.name, u.name, got)
+		}
+	}
+
+	// Standard sea-level pressure is just over 1013 hectopascals.
+	if got, want := Atmosphere.Hectopascals(), 1013.25; !cmp.Equal(got, want, cmpopts.EquateApprox(0, pressureEpsilon)) {
+		t.Errorf("Atmosphere.Hectopascals() = %v, want %v", got, want)
+	}
+}
+
+func TestPressureString(t *testing.T) {
+	tests := []struct {
+		p Pressure
+		s string
+	}{
+		{0 * Pascal, "0Pa"},
+		{1 * Pascal, "1Pa"},
+		{1 * Hectopascal, "1hPa"},
+		{1013.25 * Hectopascal, "101.325kPa"},
+		{1 * Kilopascal, "1kPa"},
+		{1e8 * Pascal, "1e+08Pa"},
+	}
+	for _, test := range tests {
+		if got, want := test.p.String(), test.s; got != want {
+			t.Errorf("(%#v).String() = %#v, want %#v", test.p, got, want)
+		}
+	}
+}
+
+func TestForceOverArea(t *testing.T) {
+	got := (1 * Newton).Over(1 * SquareMeter)
+	want := 1 * Pascal
+	if !cmp.Equal(got, want, cmpopts.EquateApprox(0, pressureEpsilon)) {
+		t.Errorf("(1 * Newton).Over(1 * SquareMeter) = %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package promexport helps export unit quantities to Prometheus metrics
+// without requiring the unit module to depend on client_golang. Register
+// accepts any collector matching Setter or Adder structurally, which a
+// prometheus.Gauge or prometheus.Counter already does.
+package promexport
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/google/go-units/unit"
+)
+
+// Setter is satisfied by prometheus.Gauge, among others.
+type Setter interface {
+	Set(float64)
+}
+
+// Adder is satisfied by prometheus.Counter and prometheus.Gauge, among
+// others.
+type Adder interface {
+	Add(float64)
+}
+
+// Register sets or increments collector from q, expressed in q's canonical
+// Prometheus base unit (see unit.MetricName). If collector implements
+// Setter, Register calls Set; otherwise, if it implements Adder, Register
+// calls Add. It returns an error if collector implements neither, or if q is
+// not a supported unit type.
+func Register(collector any, q any) error {
+	_, value, err := metricValue(q)
+	if err != nil {
+		return err
+	}
+	switch c := collector.(type) {
+	case Setter:
+		c.Set(value)
+	case Adder:
+		c.Add(value)
+	default:
+		return fmt.Errorf("promexport: collector %T implements neither Setter nor Adder", collector)
+	}
+	return nil
+}
+
+func metricValue(q any) (name string, value float64, err error) {
+	name, value = unit.MetricName("", q)
+	if math.IsNaN(value) {
+		return "", 0, fmt.Errorf("promexport: %T is not a supported unit quantity", q)
+	}
+	return name, value, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promexport
+
+import (
+	"testing"
+
+	"github.com/google/go-units/unit"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// fakeGauge stands in for a prometheus.Gauge without depending on
+// client_golang.
+type fakeGauge struct{ value float64 }
+
+func (g *fakeGauge) Set(v float64) { g.value = v }
+
+// fakeCounter stands in for a prometheus.Counter without depending on
+// client_golang.
+type fakeCounter struct{ total float64 }
+
+func (c *fakeCounter) Add(v float64) { c.total += v }
+
+func TestRegisterGauge(t *testing.T) {
+	var g fakeGauge
+	if err := Register(&g, 29031*unit.Foot); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	if got, want := g.value, 29031*0.3048; !cmp.Equal(got, want, cmpopts.EquateApprox(0, 1e-6)) {
+		t.Errorf("gauge value = %v, want %v", got, want)
+	}
+}
+
+func TestRegisterCounter(t *testing.T) {
+	var c fakeCounter
+	if err := Register(&c, 20*unit.Knot); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	if got, want := c.total, (20 * unit.Knot).MetersPerSecond(); !cmp.Equal(got, want, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("counter total = %v, want %v", got, want)
+	}
+}
+
+func TestRegisterUnsupportedQuantity(t *testing.T) {
+	var g fakeGauge
+	if err := Register(&g, "not a quantity"); err == nil {
+		t.Error("Register of an unsupported quantity succeeded, want error")
+	}
+}
+
+func TestRegisterUnsupportedCollector(t *testing.T) {
+	if err := Register(struct{}{}, 5*unit.Meter); err == nil {
+		t.Error("Register with an unsupported collector succeeded, want error")
+	}
+}
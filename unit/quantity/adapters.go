@@ -0,0 +1,68 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file adapts unit's hand-written dimensions onto Quantity, so code
+// that already uses unit.Length, unit.Speed, and unit.Temperature can cross
+// into the general Quantity system (e.g. to combine a Length with a
+// Quantity this package has no dedicated type for) without giving up those
+// types elsewhere.
+
+package quantity
+
+import "github.com/google/go-units/unit"
+
+// LengthQuantity returns l as a Quantity in meters.
+func LengthQuantity(l unit.Length) Quantity {
+	return Quantity{Value: l.Meters(), Unit: Meter}
+}
+
+// ToLength converts q to a unit.Length, returning an error if q's dimension
+// isn't length.
+func ToLength(q Quantity) (unit.Length, error) {
+	converted, err := q.To(Meter)
+	if err != nil {
+		return 0, err
+	}
+	return unit.Length(converted.Value), nil
+}
+
+// SpeedQuantity returns s as a Quantity in meters per second.
+func SpeedQuantity(s unit.Speed) Quantity {
+	return Quantity{Value: s.MetersPerSecond(), Unit: Meter.Div(Second)}
+}
+
+// ToSpeed converts q to a unit.Speed, returning an error if q's dimension
+// isn't length/time.
+func ToSpeed(q Quantity) (unit.Speed, error) {
+	converted, err := q.To(Meter.Div(Second))
+	if err != nil {
+		return 0, err
+	}
+	return unit.Speed(converted.Value), nil
+}
+
+// TemperatureQuantity returns t as a Quantity in Kelvin.
+func TemperatureQuantity(t unit.Temperature) Quantity {
+	return Quantity{Value: t.Kelvin(), Unit: Kelvin}
+}
+
+// ToTemperature converts q to a unit.Temperature, returning an error if q's
+// dimension isn't temperature.
+func ToTemperature(q Quantity) (unit.Temperature, error) {
+	converted, err := q.To(Kelvin)
+	if err != nil {
+		return 0, err
+	}
+	return unit.Temperature(converted.Value), nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantity
+
+import (
+	"testing"
+
+	"github.com/google/go-units/unit"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestLengthAdapterRoundTrip(t *testing.T) {
+	in := 5 * unit.Kilometer
+	out, err := ToLength(LengthQuantity(in))
+	if err != nil {
+		t.Fatalf("ToLength(LengthQuantity(%#v)) failed: %v", in, err)
+	}
+	if !cmp.Equal(out, in, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("round trip of %#v through Quantity = %#v", in, out)
+	}
+}
+
+func TestSpeedAdapterRoundTrip(t *testing.T) {
+	in := 20 * unit.Knot
+	out, err := ToSpeed(SpeedQuantity(in))
+	if err != nil {
+		t.Fatalf("ToSpeed(SpeedQuantity(%#v)) failed: %v", in, err)
+	}
+	if !cmp.Equal(out, in, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("round trip of %#v through Quantity = %#v", in, out)
+	}
+}
+
+func TestTemperatureAdapterRoundTrip(t *testing.T) {
+	in := unit.TemperatureFromDegreesCelsius(15)
+	out, err := ToTemperature(TemperatureQuantity(in))
+	if err != nil {
+		t.Fatalf("ToTemperature(TemperatureQuantity(%#v)) failed: %v", in, err)
+	}
+	if !cmp.Equal(out, in, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("round trip of %#v through Quantity = %#v", in, out)
+	}
+}
+
+func TestLengthAdapterDimensionMismatch(t *testing.T) {
+	if _, err := ToLength(TemperatureQuantity(unit.Kelvin)); err == nil {
+		t.Error("ToLength of a temperature Quantity succeeded, want error")
+	}
+}
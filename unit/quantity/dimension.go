@@ -0,0 +1,113 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package quantity generalizes the unit package's hand-written dimensions
+// (Length, Speed, Temperature, ...) into a single Quantity type built from a
+// Dimension vector, an SI Prefix, and a Unit. Where unit has one Go type and
+// one converter method per physical quantity, quantity represents any
+// quantity, including ones this package has never heard of (pressure,
+// energy, frequency, data rate), as long as its Dimension can be expressed
+// as a combination of the seven SI base dimensions.
+package quantity
+
+import "fmt"
+
+// Dimension is a signed vector of exponents over the seven SI base
+// dimensions: length (L), mass (M), time (T), electric current (I),
+// thermodynamic temperature (Theta), amount of substance (N), and luminous
+// intensity (J). For example, speed has Dimension{L: 1, T: -1}.
+type Dimension struct {
+	L, M, T, I, Theta, N, J int8
+}
+
+// The seven SI base dimensions, and Dimensionless for a pure ratio.
+var (
+	DimensionLength      = Dimension{L: 1}
+	DimensionMass        = Dimension{M: 1}
+	DimensionTime        = Dimension{T: 1}
+	DimensionCurrent     = Dimension{I: 1}
+	DimensionTemperature = Dimension{Theta: 1}
+	DimensionAmount      = Dimension{N: 1}
+	DimensionLuminosity  = Dimension{J: 1}
+	Dimensionless        = Dimension{}
+)
+
+// Mul returns the dimension of a quantity formed by multiplying a quantity
+// of dimension d by one of dimension other.
+func (d Dimension) Mul(other Dimension) Dimension {
+	return Dimension{
+		L:     d.L + other.L,
+		M:     d.M + other.M,
+		T:     d.T + other.T,
+		I:     d.I + other.I,
+		Theta: d.Theta + other.Theta,
+		N:     d.N + other.N,
+		J:     d.J + other.J,
+	}
+}
+
+// Div returns the dimension of a quantity formed by dividing a quantity of
+// dimension d by one of dimension other.
+func (d Dimension) Div(other Dimension) Dimension {
+	return d.Mul(other.Pow(-1))
+}
+
+// Pow returns the dimension of a quantity formed by raising a quantity of
+// dimension d to the power n.
+func (d Dimension) Pow(n int) Dimension {
+	return Dimension{
+		L:     d.L * int8(n),
+		M:     d.M * int8(n),
+		T:     d.T * int8(n),
+		I:     d.I * int8(n),
+		Theta: d.Theta * int8(n),
+		N:     d.N * int8(n),
+		J:     d.J * int8(n),
+	}
+}
+
+// String returns a compact representation of d, e.g. "L/T" for speed, "1"
+// for Dimensionless.
+func (d Dimension) String() string {
+	num, den := "", ""
+	for _, axis := range []struct {
+		symbol string
+		exp    int8
+	}{
+		{"L", d.L}, {"M", d.M}, {"T", d.T}, {"I", d.I}, {"Theta", d.Theta}, {"N", d.N}, {"J", d.J},
+	} {
+		switch {
+		case axis.exp == 0:
+			continue
+		case axis.exp == 1:
+			num += axis.symbol
+		case axis.exp > 0:
+			num += fmt.Sprintf("%s^%d", axis.symbol, axis.exp)
+		case axis.exp == -1:
+			den += axis.symbol
+		default:
+			den += fmt.Sprintf("%s^%d", axis.symbol, -axis.exp)
+		}
+	}
+	switch {
+	case num == "" && den == "":
+		return "1"
+	case den == "":
+		return num
+	case num == "":
+		return "1/" + den
+	default:
+		return num + "/" + den
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantity
+
+import "testing"
+
+func TestDimensionArithmetic(t *testing.T) {
+	speed := DimensionLength.Div(DimensionTime)
+	if want := (Dimension{L: 1, T: -1}); speed != want {
+		t.Errorf("DimensionLength.Div(DimensionTime) = %+v, want %+v", speed, want)
+	}
+
+	acceleration := speed.Div(DimensionTime)
+	if want := (Dimension{L: 1, T: -2}); acceleration != want {
+		t.Errorf("speed.Div(DimensionTime) = %+v, want %+v", acceleration, want)
+	}
+
+	force := DimensionMass.Mul(acceleration)
+	if want := (Dimension{L: 1, M: 1, T: -2}); force != want {
+		t.Errorf("DimensionMass.Mul(acceleration) = %+v, want %+v", force, want)
+	}
+
+	if got := force.Mul(force.Pow(-1)); got != Dimensionless {
+		t.Errorf("force.Mul(force.Pow(-1)) = %+v, want Dimensionless", got)
+	}
+}
+
+func TestDimensionString(t *testing.T) {
+	tests := []struct {
+		dim  Dimension
+		want string
+	}{
+		{Dimensionless, "1"},
+		{DimensionLength, "L"},
+		{Dimension{L: 1, T: -1}, "L/T"},
+		{Dimension{L: 1, T: -2}, "L/T^2"},
+		{Dimension{T: -1}, "1/T"},
+		{Dimension{L: 1, M: 1, T: -2}, "LM/T^2"},
+	}
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			if got := test.dim.String(); got != test.want {
+				t.Errorf("(%+v).String() = %q, want %q", test.dim, got, test.want)
+			}
+		})
+	}
+}
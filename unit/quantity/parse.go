@@ -0,0 +1,148 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantity
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// baseUnit is one symbol Parse and ParseUnit recognize directly, without
+// decomposing it into a prefix plus a base symbol.
+type baseUnit struct {
+	symbol       string
+	unit         Unit
+	siPrefixable bool
+}
+
+// baseUnits lists the symbols ParseUnit understands. SI prefixes
+// (prefixSpellings) additionally combine with every siPrefixable entry, so
+// "km" and "µg" are recognized without being listed here explicitly.
+var baseUnits = []baseUnit{
+	{"m", Meter, true},
+	{"g", Gram, true},
+	{"s", Second, true},
+	{"A", Ampere, true},
+	{"K", Kelvin, true},
+	{"mol", Mole, true},
+	{"cd", Candela, true},
+	{"h", Hour, false},
+	{"min", Minute, false},
+}
+
+// prefixSpelling is one symbol ParseUnit recognizes as a Prefix.
+type prefixSpelling struct {
+	prefix Prefix
+	symbol string
+}
+
+// prefixSpellings lists the SI and binary prefix symbols ParseUnit
+// understands. A prefix is accepted only when the rest of the symbol is a
+// prefixable base symbol; otherwise the next entry is tried.
+var prefixSpellings = []prefixSpelling{
+	{Yotta, "Y"}, {Zetta, "Z"}, {Exa, "E"}, {Peta, "P"}, {Tera, "T"}, {Giga, "G"}, {Mega, "M"},
+	{Yobi, "Yi"}, {Zebi, "Zi"}, {Exbi, "Ei"}, {Pebi, "Pi"}, {Tebi, "Ti"}, {Gibi, "Gi"}, {Mebi, "Mi"}, {Kibi, "Ki"},
+	{Deca, "da"}, {Kilo, "k"}, {Hecto, "h"}, {Deci, "d"}, {Centi, "c"}, {Milli, "m"},
+	{Micro, "µ"}, {Micro, "u"}, {Nano, "n"}, {Pico, "p"}, {Femto, "f"}, {Atto, "a"}, {Zepto, "z"}, {Yocto, "y"},
+}
+
+// unitTokenRE splits a unit expression into "*"/"/" operators and the
+// [prefix]symbol(^exponent)? tokens between them.
+var unitTokenRE = regexp.MustCompile(`[*/]|[^*/]+`)
+
+// ParseUnit parses a compound unit expression formed from
+// [prefix]symbol(^exponent)? tokens combined with * and /, such as "km/h",
+// "m/s^2", or "kg*m/s^2".
+func ParseUnit(s string) (Unit, error) {
+	s = strings.TrimSpace(s)
+	dim := Dimensionless
+	scale := 1.0
+	sign := 1
+	any := false
+	for _, token := range unitTokenRE.FindAllString(s, -1) {
+		switch token {
+		case "*":
+			sign = 1
+			continue
+		case "/":
+			sign = -1
+			continue
+		}
+		base, exp, err := parseUnitToken(token)
+		if err != nil {
+			return Unit{}, fmt.Errorf("quantity: cannot parse unit %q: %w", s, err)
+		}
+		powered := base.Pow(exp * sign)
+		dim = dim.Mul(powered.Dim)
+		scale *= powered.Scale
+		any = true
+	}
+	if !any {
+		return Unit{}, fmt.Errorf("quantity: cannot parse unit %q: empty expression", s)
+	}
+	return Unit{Dim: dim, Scale: scale, Symbol: s}, nil
+}
+
+// parseUnitToken parses a single [prefix]symbol(^exponent)? token, e.g.
+// "km", "s^2", or "h".
+func parseUnitToken(token string) (Unit, int, error) {
+	symbol := token
+	exp := 1
+	if i := strings.IndexByte(token, '^'); i >= 0 {
+		symbol = token[:i]
+		n, err := strconv.Atoi(token[i+1:])
+		if err != nil {
+			return Unit{}, 0, fmt.Errorf("invalid exponent in %q: %w", token, err)
+		}
+		exp = n
+	}
+	for _, b := range baseUnits {
+		if b.symbol == symbol {
+			return b.unit, exp, nil
+		}
+	}
+	for _, p := range prefixSpellings {
+		rest, ok := strings.CutPrefix(symbol, p.symbol)
+		if !ok || rest == "" {
+			continue
+		}
+		for _, b := range baseUnits {
+			if b.siPrefixable && b.symbol == rest {
+				return b.unit.WithPrefix(p.prefix), exp, nil
+			}
+		}
+	}
+	return Unit{}, 0, fmt.Errorf("unknown unit symbol %q", symbol)
+}
+
+// Parse parses s, a number followed by a unit expression (e.g. "15 km/h",
+// "9.8 m/s^2"), into a Quantity.
+func Parse(s string) (Quantity, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return Quantity{}, fmt.Errorf("quantity: %q is not of the form \"<number> <unit>\"", s)
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return Quantity{}, fmt.Errorf("quantity: invalid number in %q: %w", s, err)
+	}
+	u, err := ParseUnit(fields[1])
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Value: value, Unit: u}, nil
+}
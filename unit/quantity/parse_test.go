@@ -0,0 +1,59 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantity
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in       string
+		wantDim  Dimension
+		wantBase float64 // value expressed in the dimension's SI base unit
+	}{
+		{"15 km/h", Dimension{L: 1, T: -1}, 15 * 1000.0 / 3600.0},
+		{"9.8 m/s^2", Dimension{L: 1, T: -2}, 9.8},
+		{"2 kg*m/s^2", Dimension{L: 1, M: 1, T: -2}, 2},
+		{"5 m^2", Dimension{L: 2}, 5},
+		{"-3.5 µm", DimensionLength, -3.5e-6},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			got, err := Parse(test.in)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", test.in, err)
+			}
+			if got.Unit.Dim != test.wantDim {
+				t.Errorf("Parse(%q).Unit.Dim = %v, want %v", test.in, got.Unit.Dim, test.wantDim)
+			}
+			if gotBase := got.base(); !cmp.Equal(gotBase, test.wantBase, cmpopts.EquateApprox(0, 1e-9)) {
+				t.Errorf("Parse(%q) in base units = %v, want %v", test.in, gotBase, test.wantBase)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	if _, err := Parse("15"); err == nil {
+		t.Error(`Parse("15") succeeded, want error`)
+	}
+	if _, err := ParseUnit("parsecs"); err == nil {
+		t.Error(`ParseUnit("parsecs") succeeded, want error`)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantity
+
+// Prefix is an SI metric prefix (Yocto..Yotta) or IEC binary prefix
+// (Kibi..Yobi) that scales a Unit.
+type Prefix int
+
+// The SI metric prefixes and the IEC binary prefixes.
+const (
+	NoPrefix Prefix = iota
+	Yocto
+	Zepto
+	Atto
+	Femto
+	Pico
+	Nano
+	Micro
+	Milli
+	Centi
+	Deci
+	Deca
+	Hecto
+	Kilo
+	Mega
+	Giga
+	Tera
+	Peta
+	Exa
+	Zetta
+	Yotta
+	Kibi
+	Mebi
+	Gibi
+	Tebi
+	Pebi
+	Exbi
+	Zebi
+	Yobi
+)
+
+type prefixInfo struct {
+	symbol string
+	factor float64
+}
+
+var prefixInfos = map[Prefix]prefixInfo{
+	NoPrefix: {"", 1},
+	Yocto:    {"y", 1e-24},
+	Zepto:    {"z", 1e-21},
+	Atto:     {"a", 1e-18},
+	Femto:    {"f", 1e-15},
+	Pico:     {"p", 1e-12},
+	Nano:     {"n", 1e-9},
+	Micro:    {"µ", 1e-6},
+	Milli:    {"m", 1e-3},
+	Centi:    {"c", 1e-2},
+	Deci:     {"d", 1e-1},
+	Deca:     {"da", 1e1},
+	Hecto:    {"h", 1e2},
+	Kilo:     {"k", 1e3},
+	Mega:     {"M", 1e6},
+	Giga:     {"G", 1e9},
+	Tera:     {"T", 1e12},
+	Peta:     {"P", 1e15},
+	Exa:      {"E", 1e18},
+	Zetta:    {"Z", 1e21},
+	Yotta:    {"Y", 1e24},
+	Kibi:     {"Ki", 1 << 10},
+	Mebi:     {"Mi", 1 << 20},
+	Gibi:     {"Gi", 1 << 30},
+	Tebi:     {"Ti", 1 << 40},
+	Pebi:     {"Pi", 1 << 50},
+	Exbi:     {"Ei", 1 << 60},
+	Zebi:     {"Zi", 1 << 60 * 1024},
+	Yobi:     {"Yi", 1 << 60 * 1024 * 1024},
+}
+
+// Symbol returns p's conventional symbol, e.g. "k" for Kilo, "Mi" for Mebi.
+func (p Prefix) Symbol() string { return prefixInfos[p].symbol }
+
+// Factor returns the multiplier p applies to a unit, e.g. 1000 for Kilo.
+func (p Prefix) Factor() float64 { return prefixInfos[p].factor }
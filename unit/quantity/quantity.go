@@ -0,0 +1,78 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantity
+
+import "fmt"
+
+// Quantity is a measurement: a value expressed in a Unit.
+type Quantity struct {
+	Value float64
+	Unit  Unit
+}
+
+// New returns a Quantity of value expressed in u.
+func New(value float64, u Unit) Quantity {
+	return Quantity{Value: value, Unit: u}
+}
+
+// base returns q's value expressed in its dimension's SI base unit.
+func (q Quantity) base() float64 {
+	return q.Value * q.Unit.Scale
+}
+
+// To converts q to u, returning an error if their dimensions disagree.
+func (q Quantity) To(u Unit) (Quantity, error) {
+	if q.Unit.Dim != u.Dim {
+		return Quantity{}, fmt.Errorf("quantity: cannot convert %v to %s: dimension mismatch (%v vs %v)", q, u.Symbol, q.Unit.Dim, u.Dim)
+	}
+	return Quantity{Value: q.base() / u.Scale, Unit: u}, nil
+}
+
+// Add returns q+other, expressed in q's unit. It returns an error if the two
+// quantities' dimensions disagree.
+func (q Quantity) Add(other Quantity) (Quantity, error) {
+	converted, err := other.To(q.Unit)
+	if err != nil {
+		return Quantity{}, fmt.Errorf("quantity: cannot add %v and %v: %w", q, other, err)
+	}
+	return Quantity{Value: q.Value + converted.Value, Unit: q.Unit}, nil
+}
+
+// Sub returns q-other, expressed in q's unit. It returns an error if the two
+// quantities' dimensions disagree.
+func (q Quantity) Sub(other Quantity) (Quantity, error) {
+	converted, err := other.To(q.Unit)
+	if err != nil {
+		return Quantity{}, fmt.Errorf("quantity: cannot subtract %v from %v: %w", other, q, err)
+	}
+	return Quantity{Value: q.Value - converted.Value, Unit: q.Unit}, nil
+}
+
+// Mul returns q*other. Unlike Add and Sub, multiplication is always
+// well-defined: the result's dimension is the product of q's and other's.
+func (q Quantity) Mul(other Quantity) Quantity {
+	return Quantity{Value: q.Value * other.Value, Unit: q.Unit.Mul(other.Unit)}
+}
+
+// Div returns q/other. Unlike Add and Sub, division is always well-defined:
+// the result's dimension is the quotient of q's and other's.
+func (q Quantity) Div(other Quantity) Quantity {
+	return Quantity{Value: q.Value / other.Value, Unit: q.Unit.Div(other.Unit)}
+}
+
+// String returns a compact representation of q, e.g. "15 km/h".
+func (q Quantity) String() string {
+	return fmt.Sprintf("%g %s", q.Value, q.Unit.Symbol)
+}
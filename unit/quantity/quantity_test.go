@@ -0,0 +1,92 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantity
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestQuantityConvertAndDimensionalEquality(t *testing.T) {
+	kmh := New(36, Kilometer.Div(Hour))
+	ms, err := kmh.To(Meter.Div(Second))
+	if err != nil {
+		t.Fatalf("To(m/s) failed: %v", err)
+	}
+	if got, want := ms.Value, 10.0; !cmp.Equal(got, want, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("36km/h in m/s = %v, want %v", got, want)
+	}
+
+	// Meter/Second and Kilometer/Hour are dimensionally equal, even though
+	// their scales differ.
+	if got, want := Meter.Div(Second).Dim, Kilometer.Div(Hour).Dim; got != want {
+		t.Errorf("Meter/Second dimension = %v, want %v (Kilometer/Hour)", got, want)
+	}
+}
+
+func TestQuantityToDimensionMismatch(t *testing.T) {
+	meters := New(5, Meter)
+	if _, err := meters.To(Kelvin); err == nil {
+		t.Error("converting meters to Kelvin succeeded, want error")
+	}
+}
+
+func TestQuantityAddSub(t *testing.T) {
+	a := New(5, Kilometer)
+	b := New(500, Meter)
+
+	sum, err := a.Add(b)
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if got, want := sum.Value, 5.5; !cmp.Equal(got, want, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("5km + 500m = %v %s, want %v", got, sum.Unit.Symbol, want)
+	}
+
+	diff, err := a.Sub(b)
+	if err != nil {
+		t.Fatalf("Sub failed: %v", err)
+	}
+	if got, want := diff.Value, 4.5; !cmp.Equal(got, want, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("5km - 500m = %v %s, want %v", got, diff.Unit.Symbol, want)
+	}
+
+	if _, err := a.Add(New(1, Kelvin)); err == nil {
+		t.Error("adding km and K succeeded, want error")
+	}
+}
+
+func TestQuantityMulDiv(t *testing.T) {
+	length := New(5, Meter)
+	duration := New(2, Second)
+
+	speed := length.Div(duration)
+	if want := (Dimension{L: 1, T: -1}); speed.Unit.Dim != want {
+		t.Errorf("(5m / 2s).Unit.Dim = %v, want %v", speed.Unit.Dim, want)
+	}
+	if got, want := speed.Value, 2.5; !cmp.Equal(got, want, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("(5m / 2s).Value = %v, want %v", got, want)
+	}
+
+	area := length.Mul(length)
+	if want := (Dimension{L: 2}); area.Unit.Dim != want {
+		t.Errorf("(5m * 5m).Unit.Dim = %v, want %v", area.Unit.Dim, want)
+	}
+	if got, want := area.Value, 25.0; !cmp.Equal(got, want, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("(5m * 5m).Value = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantity
+
+import (
+	"fmt"
+	"math"
+)
+
+// Unit is a unit of measurement: a Dimension paired with Scale, the value of
+// one of this unit expressed in the dimension's SI base unit (e.g. 1000 for
+// Kilometer, since 1km = 1000m).
+type Unit struct {
+	Dim    Dimension
+	Scale  float64
+	Symbol string
+}
+
+// The seven SI base units.
+var (
+	Meter   = Unit{Dim: DimensionLength, Scale: 1, Symbol: "m"}
+	Gram    = Unit{Dim: DimensionMass, Scale: 1e-3, Symbol: "g"}
+	Second  = Unit{Dim: DimensionTime, Scale: 1, Symbol: "s"}
+	Ampere  = Unit{Dim: DimensionCurrent, Scale: 1, Symbol: "A"}
+	Kelvin  = Unit{Dim: DimensionTemperature, Scale: 1, Symbol: "K"}
+	Mole    = Unit{Dim: DimensionAmount, Scale: 1, Symbol: "mol"}
+	Candela = Unit{Dim: DimensionLuminosity, Scale: 1, Symbol: "cd"}
+
+	// Kilogram is the SI base unit of mass; unlike the other base units, SI
+	// prefixes apply to Gram, not Kilogram.
+	Kilogram = Gram.WithPrefix(Kilo)
+
+	// Minute and Hour are common non-SI time units accepted alongside
+	// Second.
+	Minute = Unit{Dim: DimensionTime, Scale: 60, Symbol: "min"}
+	Hour   = Unit{Dim: DimensionTime, Scale: 3600, Symbol: "h"}
+
+	// Kilometer is a common enough prefixed unit to export directly, rather
+	// than requiring callers to write Meter.WithPrefix(Kilo).
+	Kilometer = Meter.WithPrefix(Kilo)
+)
+
+// WithPrefix returns u scaled by p, with p's symbol prepended, e.g.
+// Meter.WithPrefix(Kilo) is Kilometer.
+func (u Unit) WithPrefix(p Prefix) Unit {
+	return Unit{Dim: u.Dim, Scale: u.Scale * p.Factor(), Symbol: p.Symbol() + u.Symbol}
+}
+
+// Mul returns the unit of a quantity formed by multiplying a quantity in u
+// by one in other, e.g. Newton is Kilogram.Mul(Meter).Div(Second.Pow(2)).
+func (u Unit) Mul(other Unit) Unit {
+	return Unit{Dim: u.Dim.Mul(other.Dim), Scale: u.Scale * other.Scale, Symbol: u.Symbol + "*" + other.Symbol}
+}
+
+// Div returns the unit of a quantity formed by dividing a quantity in u by
+// one in other, e.g. KilometerPerHour is Kilometer.Div(Hour).
+func (u Unit) Div(other Unit) Unit {
+	return Unit{Dim: u.Dim.Div(other.Dim), Scale: u.Scale / other.Scale, Symbol: u.Symbol + "/" + other.Symbol}
+}
+
+// Pow returns the unit of a quantity formed by raising a quantity in u to
+// the power n, e.g. SquareMeter is Meter.Pow(2).
+func (u Unit) Pow(n int) Unit {
+	symbol := u.Symbol
+	if n != 1 {
+		symbol = fmt.Sprintf("%s^%d", u.Symbol, n)
+	}
+	return Unit{Dim: u.Dim.Pow(n), Scale: math.Pow(u.Scale, float64(n)), Symbol: symbol}
+}
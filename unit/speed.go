@@ -16,6 +16,8 @@ package unit
 
 import (
 	"fmt"
+	"math"
+	"reflect"
 	"time"
 )
 
@@ -35,6 +37,14 @@ const (
 	hourInSeconds = Speed(time.Hour / time.Second)
 )
 
+// Abs returns the speed as an absolute value.
+func (s Speed) Abs() Speed {
+	if s < 0 {
+		return -s
+	}
+	return s
+}
+
 // MetersPerSecond returns the speed in meters per second.
 func (s Speed) MetersPerSecond() float64 {
 	return float64(s / MeterPerSecond)
@@ -78,3 +88,69 @@ func (s Speed) String() string {
 func (s Speed) GoString() string {
 	return fmt.Sprintf("%g * MeterPerSecond", s.MetersPerSecond())
 }
+
+// StringImperial returns a string representation of the speed like String,
+// but in miles per hour rather than meters per second.
+func (s Speed) StringImperial() string {
+	return fmt.Sprintf("%g mph", s.MilesPerHour())
+}
+
+// Format implements fmt.Formatter. %v and %s render as String does; %#v
+// renders as GoString does; %g, %e, and %f (and their upper-case forms)
+// render the speed in meters per second honoring the usual flags, width,
+// and precision.
+func (s Speed) Format(f fmt.State, verb rune) {
+	formatState(f, verb, s.MetersPerSecond(), s.String, s.GoString)
+}
+
+// Formatf renders the speed using a layout combining a printf numeric verb
+// with the name of the target unit, e.g. s.Formatf("%.1f km/h").
+func (s Speed) Formatf(layout string) string {
+	return formatf(reflect.TypeOf(s), s.MetersPerSecond(), layout)
+}
+
+// beaufortUpperBounds holds, for Beaufort forces 0 through 11, the wind
+// speed (in meters per second) below which that force applies. A speed at
+// or above the last entry is force 12.
+var beaufortUpperBounds = [...]float64{0.5, 1.6, 3.4, 5.5, 8.0, 10.8, 13.9, 17.2, 20.8, 24.5, 28.5, 32.7}
+
+// Beaufort returns the Beaufort wind force scale number, from 0 (calm) to
+// 12 (hurricane force), corresponding to the speed.
+func (s Speed) Beaufort() int {
+	mps := s.Abs().MetersPerSecond()
+	for force, upperBound := range beaufortUpperBounds {
+		if mps < upperBound {
+			return force
+		}
+	}
+	return 12
+}
+
+// BeaufortSpeed returns the lower bound of the wind speed range for the
+// given Beaufort force. Values of force outside [0, 12] are clamped to that
+// range.
+func BeaufortSpeed(force int) Speed {
+	switch {
+	case force <= 0:
+		return 0
+	case force >= 12:
+		return Speed(beaufortUpperBounds[11]) * MeterPerSecond
+	default:
+		return Speed(beaufortUpperBounds[force-1]) * MeterPerSecond
+	}
+}
+
+// WindChill returns the apparent temperature felt by exposed skin, combining
+// the effect of wind speed s and air temperature t, using the National
+// Weather Service's 2001 wind chill formula. It is only valid for
+// t <= 50°F and s >= 3mph; outside that domain it returns an error.
+func (s Speed) WindChill(t Temperature) (Temperature, error) {
+	tf := t.DegreesFahrenheit()
+	v := s.MilesPerHour()
+	if tf > 50 || v < 3 {
+		return 0, fmt.Errorf("unit: WindChill is only defined for T <= 50°F and wind speed >= 3mph, got %.1f°F and %.1fmph", tf, v)
+	}
+	vp := math.Pow(v, 0.16)
+	wc := 35.74 + 0.6215*tf - 35.75*vp + 0.4275*tf*vp
+	return TemperatureFromDegreesFahrenheit(wc), nil
+}
@@ -97,3 +97,68 @@ func TestSpeedGoString(t *testing.T) {
 		}
 	}
 }
+
+func TestBeaufort(t *testing.T) {
+	tests := []struct {
+		s    Speed
+		want int
+	}{
+		{0 * MeterPerSecond, 0},
+		{0.2 * MeterPerSecond, 0},
+		{1.5 * MeterPerSecond, 1},
+		{5 * MeterPerSecond, 3},
+		{17 * MeterPerSecond, 7},
+		{32.7 * MeterPerSecond, 12},
+		{50 * MeterPerSecond, 12},
+	}
+	for _, test := range tests {
+		if got := test.s.Beaufort(); got != test.want {
+			t.Errorf("(%#v).Beaufort() = %v, want %v", test.s, got, test.want)
+		}
+	}
+}
+
+func TestBeaufortSpeed(t *testing.T) {
+	tests := []struct {
+		force int
+		want  Speed
+	}{
+		{-1, 0 * MeterPerSecond},
+		{0, 0 * MeterPerSecond},
+		{1, 0.5 * MeterPerSecond},
+		{4, 5.5 * MeterPerSecond},
+		{12, 32.7 * MeterPerSecond},
+		{20, 32.7 * MeterPerSecond},
+	}
+	for _, test := range tests {
+		if got := BeaufortSpeed(test.force); got != test.want {
+			t.Errorf("BeaufortSpeed(%v) = %#v, want %#v", test.force, got, test.want)
+		}
+	}
+}
+
+func TestBeaufortRoundTrip(t *testing.T) {
+	for force := 0; force < 12; force++ {
+		s := BeaufortSpeed(force)
+		if got := s.Beaufort(); got != force {
+			t.Errorf("BeaufortSpeed(%v).Beaufort() = %v, want %v", force, got, force)
+		}
+	}
+}
+
+func TestWindChill(t *testing.T) {
+	wc, err := (10 * MilePerHour).WindChill(TemperatureFromDegreesFahrenheit(30))
+	if err != nil {
+		t.Fatalf("WindChill returned error: %v", err)
+	}
+	if got, want := wc.DegreesFahrenheit(), 21.25; !cmp.Equal(got, want, cmpopts.EquateApprox(0, 1e-2)) {
+		t.Errorf("WindChill(30°F, 10mph) = %.2f°F, want %.2f°F", got, want)
+	}
+
+	if _, err := (10 * MilePerHour).WindChill(TemperatureFromDegreesFahrenheit(70)); err == nil {
+		t.Error("WindChill(70°F, 10mph) succeeded, want error outside validity domain")
+	}
+	if _, err := (1 * MilePerHour).WindChill(TemperatureFromDegreesFahrenheit(30)); err == nil {
+		t.Error("WindChill(30°F, 1mph) succeeded, want error outside validity domain")
+	}
+}
@@ -16,6 +16,7 @@ package unit
 
 import (
 	"fmt"
+	"strings"
 )
 
 // Temperature represents a thermodynamic temperature measurement in Kelvin as
@@ -81,14 +82,176 @@ func (t Temperature) DegreesRankine() float64 {
 	return float64(t / Rankine)
 }
 
-// String returns a string representation of the temperature in Kelvin using
-// compact number syntax (e.g. "294.15 K", "5778 K", "1.57e+07 K").
+// HeatIndex returns the apparent temperature felt by the human body, given
+// the relative humidity rh as a percentage (0-100), using the National
+// Weather Service's Rothfusz regression. It is only valid for t >= 80°F and
+// rh >= 40; outside that domain it returns an error.
+func (t Temperature) HeatIndex(rh float64) (Temperature, error) {
+	tf := t.DegreesFahrenheit()
+	if tf < 80 || rh < 40 {
+		return 0, fmt.Errorf("unit: HeatIndex is only defined for T >= 80°F and RH >= 40%%, got %.1f°F and %.1f%%", tf, rh)
+	}
+	hi := -42.379 + 2.04901523*tf + 10.14333127*rh - 0.22475541*tf*rh -
+		0.00683783*tf*tf - 0.05481717*rh*rh + 0.00122874*tf*tf*rh +
+		0.00085282*tf*rh*rh - 0.00000199*tf*tf*rh*rh
+	return TemperatureFromDegreesFahrenheit(hi), nil
+}
+
+// TemperatureScale selects the unit String uses to render a Temperature.
+type TemperatureScale int
+
+const (
+	// AutoScale, the default, renders in Celsius for typical terrestrial
+	// temperatures (celsiusThresholdLow to celsiusThresholdHigh) and falls
+	// back to Kelvin outside
+	// that range, where Celsius would read as a large or deeply negative
+	// number.
+	AutoScale TemperatureScale = iota
+	CelsiusScale
+	FahrenheitScale
+	KelvinScale
+	RankineScale
+)
+
+// celsiusThresholdLow and celsiusThresholdHigh bound the range, in degrees
+// Celsius, in which AutoScale prefers Celsius over Kelvin.
+const (
+	celsiusThresholdLow  = -100
+	celsiusThresholdHigh = 1000
+)
+
+// preferredTemperatureScale is the scale String uses, set by
+// SetPreferredTemperatureScale.
+var preferredTemperatureScale = AutoScale
+
+// SetPreferredTemperatureScale changes the scale Temperature.String uses for
+// every subsequent call, package-wide. This is meant for programs that know
+// their audience expects a particular scale throughout, e.g. a US-locale CLI
+// passing FahrenheitScale. The default, AutoScale, prefers Celsius for
+// typical terrestrial temperatures and Kelvin otherwise.
+func SetPreferredTemperatureScale(scale TemperatureScale) {
+	preferredTemperatureScale = scale
+}
+
+// String returns a string representation of the temperature using compact
+// number syntax, in the scale set by SetPreferredTemperatureScale (e.g.
+// "21 °C", "70 °F", "5778 K"). GoString always renders in Kelvin; see
+// GoStringInCelsius for a Celsius-literal alternative.
 func (t Temperature) String() string {
-	return fmt.Sprintf("%g K", t.Kelvin())
+	switch preferredTemperatureScale {
+	case CelsiusScale:
+		return formatSignificant(t.DegreesCelsius()) + " °C"
+	case FahrenheitScale:
+		return formatSignificant(t.DegreesFahrenheit()) + " °F"
+	case KelvinScale:
+		return formatSignificant(t.Kelvin()) + " K"
+	case RankineScale:
+		return formatSignificant(t.DegreesRankine()) + " °R"
+	default:
+		if c := t.DegreesCelsius(); c >= celsiusThresholdLow && c <= celsiusThresholdHigh {
+			return formatSignificant(c) + " °C"
+		}
+		return formatSignificant(t.Kelvin()) + " K"
+	}
 }
 
-// GoString returns a Go syntax expression of the temperature (e.g.
-// "294.15 * Kelvin", "5778 * Kelvin", "1.5e+07 * Kelvin").
+// GoString returns a Go syntax expression of the temperature in Kelvin (e.g.
+// "294.15 * Kelvin", "5778 * Kelvin", "1.5e+07 * Kelvin"), regardless of the
+// preferred scale, so generated code always reproduces the exact value.
 func (t Temperature) GoString() string {
 	return fmt.Sprintf("%v * Kelvin", t.Kelvin())
 }
+
+// GoStringInCelsius returns a Go syntax expression of the temperature as a
+// call to TemperatureFromDegreesCelsius (e.g.
+// "unit.TemperatureFromDegreesCelsius(23.5)"), which is often more readable
+// than GoString's Kelvin literal in generated code aimed at human review.
+func (t Temperature) GoStringInCelsius() string {
+	return fmt.Sprintf("unit.TemperatureFromDegreesCelsius(%v)", t.DegreesCelsius())
+}
+
+// Format implements fmt.Formatter. %v and %s render as String does; %#v
+// renders as GoString does; %g, %e, and %f (and their upper-case forms)
+// render the temperature in Kelvin honoring the usual flags, width, and
+// precision. %C is a unit-specific extension that renders in degrees
+// Celsius honoring the same flags and precision, e.g. "%.2C" on 15°C prints
+// "15.00°C".
+func (t Temperature) Format(f fmt.State, verb rune) {
+	if verb == 'C' {
+		opts := FormatOptions{Scale: CelsiusScale, ShowSign: f.Flag('+')}
+		if p, ok := f.Precision(); ok {
+			opts.PrecisionMode, opts.Precision = FixedDecimals, p
+		}
+		fmt.Fprint(f, t.Render(opts))
+		return
+	}
+	formatState(f, verb, t.Kelvin(), t.String, t.GoString)
+}
+
+// scaleValueAndSymbol returns t's value and single-letter scale symbol in
+// scale, resolving AutoScale the same way String does.
+func (t Temperature) scaleValueAndSymbol(scale TemperatureScale) (float64, string) {
+	switch scale {
+	case CelsiusScale:
+		return t.DegreesCelsius(), "C"
+	case FahrenheitScale:
+		return t.DegreesFahrenheit(), "F"
+	case KelvinScale:
+		return t.Kelvin(), "K"
+	case RankineScale:
+		return t.DegreesRankine(), "R"
+	default:
+		if c := t.DegreesCelsius(); c >= celsiusThresholdLow && c <= celsiusThresholdHigh {
+			return c, "C"
+		}
+		return t.Kelvin(), "K"
+	}
+}
+
+// renderSymbol spells letter (e.g. "C", "K") according to style: "°C",
+// "degC", or "C". Kelvin conventionally omits the degree sign in every
+// style.
+func renderSymbol(style SymbolStyle, letter string) string {
+	if letter == "K" {
+		return "K"
+	}
+	switch style {
+	case WordSymbol:
+		return "deg" + letter
+	case BareSymbol:
+		return letter
+	default:
+		return "°" + letter
+	}
+}
+
+// Render formats the temperature according to opts, selecting a target
+// scale, precision, sign, and symbol style, e.g.
+//
+//	t.Render(FormatOptions{Scale: FahrenheitScale, Symbol: WordSymbol}) // "98.6degF"
+//
+// The zero FormatOptions renders in AutoScale's scale with default
+// precision and the degree-sign symbol style.
+func (t Temperature) Render(opts FormatOptions) string {
+	value, letter := t.scaleValueAndSymbol(opts.Scale)
+	return fmt.Sprintf(precisionVerb(opts), value) + renderSymbol(opts.Symbol, letter)
+}
+
+// Formatf renders the temperature using a layout combining a printf numeric
+// verb with the name of the target scale, e.g. t.Formatf("%.1f °C").
+func (t Temperature) Formatf(layout string) string {
+	m := layoutRE.FindStringSubmatch(layout)
+	if m == nil {
+		return fmt.Sprintf("%%!Formatf(invalid layout %q)", layout)
+	}
+	verb, rest := m[1], m[2]
+	unit := strings.TrimSpace(rest)
+	for _, spelling := range temperatureSpellings {
+		for _, alias := range spelling.aliases {
+			if alias == unit {
+				return fmt.Sprintf(verb, spelling.to(t)) + rest
+			}
+		}
+	}
+	return fmt.Sprintf("%%!Formatf(unknown unit %q)", unit)
+}
@@ -89,15 +89,17 @@ var temperatureStringTests = []struct {
 	s  string // String()
 	gs string // GoString()
 }{
+	// 0 K (-273.15°C) and 5778 K / 15.7e6 K fall outside AutoScale's Celsius
+	// range, so they still render in Kelvin.
 	{0 * Kelvin, "0 K", "0 * Kelvin"},
-	{294.15 * Kelvin, "294.15 K", "294.15 * Kelvin"},
-	{459.67 * Rankine, "255.37222222222223 K", "255.37222222222223 * Kelvin"},
+	{294.15 * Kelvin, "21 °C", "294.15 * Kelvin"},
+	{459.67 * Rankine, "-17.7777777777777 °C", "255.37222222222223 * Kelvin"},
 	{5778 * Kelvin, "5778 K", "5778 * Kelvin"},
 	{15.7e6 * Kelvin, "1.57e+07 K", "1.57e+07 * Kelvin"},
 }
 
 func TestTemperatureString(t *testing.T) {
-	for _, test := range stringTests {
+	for _, test := range temperatureStringTests {
 		t.Run(test.s, func(t *testing.T) {
 			if got, want := test.in.String(), test.s; got != want {
 				t.Errorf("(%#v).String() = %#v, want %#v", test.in, got, want)
@@ -107,7 +109,7 @@ func TestTemperatureString(t *testing.T) {
 }
 
 func TestTemperatureGoString(t *testing.T) {
-	for _, test := range stringTests {
+	for _, test := range temperatureStringTests {
 		t.Run(test.gs, func(t *testing.T) {
 			if got, want := test.in.GoString(), test.gs; got != want {
 				t.Errorf("(%#v).GoString() = %#v, want %#v", test.in, got, want)
@@ -115,3 +117,55 @@ func TestTemperatureGoString(t *testing.T) {
 		})
 	}
 }
+
+func TestSetPreferredTemperatureScale(t *testing.T) {
+	t.Cleanup(func() { SetPreferredTemperatureScale(AutoScale) })
+
+	boiling := TemperatureFromDegreesCelsius(100)
+
+	SetPreferredTemperatureScale(FahrenheitScale)
+	if got, want := boiling.String(), "212 °F"; got != want {
+		t.Errorf("after SetPreferredTemperatureScale(FahrenheitScale), String() = %q, want %q", got, want)
+	}
+
+	SetPreferredTemperatureScale(CelsiusScale)
+	if got, want := boiling.String(), "100 °C"; got != want {
+		t.Errorf("after SetPreferredTemperatureScale(CelsiusScale), String() = %q, want %q", got, want)
+	}
+
+	// Even far outside AutoScale's range, an explicit CelsiusScale should
+	// not fall back to Kelvin.
+	absoluteZero := temperatureFromKelvin(0)
+	if got, want := absoluteZero.String(), "-273.15 °C"; got != want {
+		t.Errorf("after SetPreferredTemperatureScale(CelsiusScale), String() = %q, want %q", got, want)
+	}
+
+	SetPreferredTemperatureScale(KelvinScale)
+	if got, want := boiling.String(), "373.15 K"; got != want {
+		t.Errorf("after SetPreferredTemperatureScale(KelvinScale), String() = %q, want %q", got, want)
+	}
+}
+
+func TestGoStringInCelsius(t *testing.T) {
+	tp := TemperatureFromDegreesCelsius(23.5)
+	if got, want := tp.GoStringInCelsius(), "unit.TemperatureFromDegreesCelsius(23.5)"; got != want {
+		t.Errorf("(%#v).GoStringInCelsius() = %q, want %q", tp, got, want)
+	}
+}
+
+func TestHeatIndex(t *testing.T) {
+	hi, err := TemperatureFromDegreesFahrenheit(95).HeatIndex(50)
+	if err != nil {
+		t.Fatalf("HeatIndex returned error: %v", err)
+	}
+	if got, want := hi.DegreesFahrenheit(), 105.2157721; !cmp.Equal(got, want, cmpopts.EquateApprox(0, 1e-4)) {
+		t.Errorf("HeatIndex(95°F, 50%%) = %.2f°F, want %.2f°F", got, want)
+	}
+
+	if _, err := TemperatureFromDegreesFahrenheit(70).HeatIndex(50); err == nil {
+		t.Error("HeatIndex(70°F, 50%) succeeded, want error outside validity domain")
+	}
+	if _, err := TemperatureFromDegreesFahrenheit(95).HeatIndex(20); err == nil {
+		t.Error("HeatIndex(95°F, 20%) succeeded, want error outside validity domain")
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import "fmt"
+
+// Volume represents a three-dimensional measurement in cubic meters as a
+// float64.
+type Volume float64
+
+// Common volume units.
+const (
+	CubicMeter      Volume = 1
+	Liter                  = 1e-3 * CubicMeter
+	Milliliter             = 1e-3 * Liter
+	CubicCentimeter        = Milliliter
+	CubicFoot              = 0.028316846592 * CubicMeter
+	CubicInch              = CubicFoot / 1728
+	USGallon               = 3.785411784 * Liter
+	ImperialGallon         = 4.54609 * Liter
+	USFluidOunce           = USGallon / 128
+)
+
+// Abs returns the volume as an absolute value.
+func (v Volume) Abs() Volume {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// CubicMeters returns the volume in cubic meters.
+func (v Volume) CubicMeters() float64 { return float64(v) }
+
+// Liters returns the volume in liters.
+func (v Volume) Liters() float64 { return float64(v / Liter) }
+
+// Milliliters returns the volume in milliliters.
+func (v Volume) Milliliters() float64 { return float64(v / Milliliter) }
+
+// CubicCentimeters returns the volume in cubic centimeters.
+func (v Volume) CubicCentimeters() float64 { return float64(v / CubicCentimeter) }
+
+// CubicFeet returns the volume in cubic feet.
+func (v Volume) CubicFeet() float64 { return float64(v / CubicFoot) }
+
+// CubicInches returns the volume in cubic inches.
+func (v Volume) CubicInches() float64 { return float64(v / CubicInch) }
+
+// USGallons returns the volume in US liquid gallons.
+func (v Volume) USGallons() float64 { return float64(v / USGallon) }
+
+// ImperialGallons returns the volume in imperial gallons.
+func (v Volume) ImperialGallons() float64 { return float64(v / ImperialGallon) }
+
+// USFluidOunces returns the volume in US fluid ounces.
+func (v Volume) USFluidOunces() float64 { return float64(v / USFluidOunce) }
+
+// String returns a string representation of the volume in cubic meters.
+//
+// If possible, the volume will be returned with an appropriate SI prefix
+// (e.g. 1.2m^3, 2.3L, 3.4mL), otherwise the volume will be returned as a
+// scientific representation in cubic meters (e.g. 1.496e+11m^3).
+func (v Volume) String() string {
+	value, desc := v.format()
+	return fmt.Sprintf("%v%v", value, desc.symbol)
+}
+
+func (v Volume) GoString() string {
+	value, desc := v.format()
+	return fmt.Sprintf("%v * %v", value, desc.name)
+}
+
+type volumeUnitDesc struct {
+	volume Volume
+	name   string
+	symbol string
+}
+
+var (
+	cubicMeterDesc = volumeUnitDesc{CubicMeter, "CubicMeter", "m^3"}
+	literDesc      = volumeUnitDesc{Liter, "Liter", "L"}
+
+	// volumeUnitThresholds contains the thresholds for SI prefixed volume
+	// values to be used when returning a string representation of the volume.
+	volumeUnitThresholds = []volumeUnitDesc{
+		// NOTE: keep in descending order so that format() works correctly.
+		cubicMeterDesc,
+		literDesc,
+		{Milliliter, "Milliliter", "mL"},
+	}
+)
+
+func (v Volume) format() (string, volumeUnitDesc) {
+	if v.Abs() >= 1000*cubicMeterDesc.volume {
+		return fmt.Sprintf("%g", v/cubicMeterDesc.volume), cubicMeterDesc
+	}
+	for _, u := range volumeUnitThresholds {
+		if v.Abs() >= u.volume {
+			return formatSignificant(float64(v / u.volume)), u
+		}
+	}
+	return fmt.Sprintf("%g", v/cubicMeterDesc.volume), cubicMeterDesc
+}
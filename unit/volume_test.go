@@ -0,0 +1,88 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unit
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+const volumeEpsilon = 1e-9
+
+func TestEmptyVolume(t *testing.T) {
+	var v Volume
+	if m := v.CubicMeters(); m != 0 {
+		t.Errorf("Empty value of Volume was %v, want 0", m)
+	}
+}
+
+func TestVolumeConversion(t *testing.T) {
+	units := []struct {
+		name    string
+		unit    Volume
+		convert func(Volume) float64
+	}{
+		{"Liter", Liter, Volume.Liters},
+		{"Milliliter", Milliliter, Volume.Milliliters},
+		{"CubicCentimeter", CubicCentimeter, Volume.CubicCentimeters},
+		{"CubicFoot", CubicFoot, Volume.CubicFeet},
+		{"CubicInch", CubicInch, Volume.CubicInches},
+		{"USGallon", USGallon, Volume.USGallons},
+		{"ImperialGallon", ImperialGallon, Volume.ImperialGallons},
+		{"USFluidOunce", USFluidOunce, Volume.USFluidOunces},
+	}
+	for _, u := range units {
+		if got := u.convert(u.unit); !cmp.Equal(got, 1.0, cmpopts.EquateApprox(0, volumeEpsilon)) {
+			t.Errorf("(1 * %v).%v() = %v, want 1", u.name, u.name, got)
+		}
+	}
+
+	// One US gallon is exactly 231 cubic inches.
+	if got, want := USGallon.CubicInches(), 231.0; !cmp.Equal(got, want, cmpopts.EquateApprox(0, volumeEpsilon)) {
+		t.Errorf("USGallon.CubicInches() = %v, want %v", got, want)
+	}
+	// One cubic centimeter is exactly one milliliter.
+	if got, want := CubicCentimeter.Milliliters(), 1.0; got != want {
+		t.Errorf("CubicCentimeter.Milliliters() = %v, want %v", got, want)
+	}
+}
+
+func TestVolumeString(t *testing.T) {
+	tests := []struct {
+		v Volume
+		s string
+	}{
+		{0 * CubicMeter, "0m^3"},
+		{1 * CubicMeter, "1m^3"},
+		{1 * Liter, "1L"},
+		{500 * Milliliter, "500mL"},
+		{1e6 * CubicMeter, "1e+06m^3"},
+	}
+	for _, test := range tests {
+		if got, want := test.v.String(), test.s; got != want {
+			t.Errorf("(%#v).String() = %#v, want %#v", test.v, got, want)
+		}
+	}
+}
+
+func TestAreaTimesLength(t *testing.T) {
+	got := (10 * SquareMeter).Times(2 * Meter)
+	want := 20 * CubicMeter
+	if !cmp.Equal(got, want, cmpopts.EquateApprox(0, volumeEpsilon)) {
+		t.Errorf("(10 * SquareMeter).Times(2 * Meter) = %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package yamlunit adds YAML support to the unit package's quantity types.
+// It lives in its own module-internal package, rather than on unit itself,
+// so that programs which never decode YAML don't pull in a yaml dependency.
+//
+// Go does not allow attaching new methods to a type from another package, so
+// each quantity is represented here as a defined type over the unit.X value,
+// convertible to and from it for free at call sites:
+//
+//	type config struct {
+//		Altitude yamlunit.Length `yaml:"altitude"`
+//	}
+//	var c config
+//	yaml.Unmarshal(data, &c)
+//	altitude := unit.Length(c.Altitude)
+package yamlunit
+
+import (
+	"github.com/google/go-units/unit"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Length is a unit.Length that implements yaml.Marshaler/Unmarshaler,
+// encoding as the same short form unit.Length.String produces (e.g.
+// "1.2km").
+type Length unit.Length
+
+// MarshalYAML implements yaml.Marshaler.
+func (l Length) MarshalYAML() (any, error) {
+	return unit.Length(l).String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (l *Length) UnmarshalYAML(value *yaml.Node) error {
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return err
+	}
+	parsed, err := unit.ParseLength(s)
+	if err != nil {
+		return err
+	}
+	*l = Length(parsed)
+	return nil
+}
+
+// Temperature is a unit.Temperature that implements yaml.Marshaler/
+// Unmarshaler, encoding as the same short form unit.Temperature.String
+// produces (e.g. "15 °C").
+type Temperature unit.Temperature
+
+// MarshalYAML implements yaml.Marshaler.
+func (t Temperature) MarshalYAML() (any, error) {
+	return unit.Temperature(t).String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *Temperature) UnmarshalYAML(value *yaml.Node) error {
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return err
+	}
+	parsed, err := unit.ParseTemperature(s)
+	if err != nil {
+		return err
+	}
+	*t = Temperature(parsed)
+	return nil
+}
+
+// Speed is a unit.Speed that implements yaml.Marshaler/Unmarshaler, encoding
+// as the same short form unit.Speed.String produces (e.g. "16.5 m/s").
+type Speed unit.Speed
+
+// MarshalYAML implements yaml.Marshaler.
+func (s Speed) MarshalYAML() (any, error) {
+	return unit.Speed(s).String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (s *Speed) UnmarshalYAML(value *yaml.Node) error {
+	var str string
+	if err := value.Decode(&str); err != nil {
+		return err
+	}
+	parsed, err := unit.ParseSpeed(str)
+	if err != nil {
+		return err
+	}
+	*s = Speed(parsed)
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yamlunit
+
+import (
+	"testing"
+
+	"github.com/google/go-units/unit"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"gopkg.in/yaml.v3"
+)
+
+func TestStationYAMLRoundTrip(t *testing.T) {
+	type station struct {
+		Altitude Length      `yaml:"altitude"`
+		Temp     Temperature `yaml:"temp"`
+		Wind     Speed       `yaml:"wind"`
+	}
+	in := station{
+		Altitude: Length(8848.65 * unit.Meter),
+		Temp:     Temperature(unit.TemperatureFromDegreesCelsius(-36.5)),
+		Wind:     Speed(16.5 * unit.MeterPerSecond),
+	}
+
+	data, err := yaml.Marshal(in)
+	if err != nil {
+		t.Fatalf("yaml.Marshal(%+v) failed: %v", in, err)
+	}
+
+	var out station
+	if err := yaml.Unmarshal(data, &out); err != nil {
+		t.Fatalf("yaml.Unmarshal(%s) failed: %v", data, err)
+	}
+	if !cmp.Equal(out, in, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("round trip of %+v through YAML = %+v", in, out)
+	}
+}